@@ -0,0 +1,195 @@
+package matrix
+
+import (
+	"math"
+)
+
+// Number is a constraint for types supporting arithmetic operators
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~complex64 | ~complex128
+}
+
+// Float is a constraint for floating-point types
+type Float interface {
+	~float32 | ~float64
+}
+
+// epsilon get machine epsilon for `F`
+func epsilon[F Float]() F {
+	var one F = 1
+	if one+F(1e-10) == one {
+		return F(1.0 / (1 << 23))
+	}
+	return F(1.0 / (1 << 52))
+}
+
+// abs get absolute value of `x`
+func abs[F Float](x F) F {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// maxAbs get the largest absolute value in `cells`
+func maxAbs[F Float](cells []F) F {
+	var res F
+	for _, cell := range cells {
+		if abs(cell) > res {
+			res = abs(cell)
+		}
+	}
+	return res
+}
+
+// sqrt get square root of `x`
+func sqrt[F Float](x F) F {
+	return F(math.Sqrt(float64(x)))
+}
+
+// hypot get sqrt(x*x + y*y) avoiding overflow
+func hypot[F Float](x, y F) F {
+	return F(math.Hypot(float64(x), float64(y)))
+}
+
+// Identity make identity matrix with size `n`
+func Identity[N Number](n int) *Matrix[N] {
+	m := NewZeroMatrix[N](n, n)
+	for i := 0; i < n; i++ {
+		m.cells[i*n+i] = 1
+	}
+	return m
+}
+
+// checkShapes check both matrices exist and have the same size
+func checkShapes[N Number](a, b *Matrix[N]) error {
+	if a == nil || b == nil {
+		return ErrNilMatrix
+	}
+	if a.rowCount != b.rowCount || a.colCount != b.colCount {
+		return dimensionError(a, b)
+	}
+	return nil
+}
+
+// elementWise make new matrix with `f` applied to each pair of cells of `a` and `b`
+func elementWise[N Number](a, b *Matrix[N], f func(x, y N) N) (*Matrix[N], error) {
+	if err := checkShapes(a, b); err != nil {
+		return nil, err
+	}
+
+	res := NewZeroMatrix[N](a.rowCount, a.colCount)
+	for i := range a.cells {
+		res.cells[i] = f(a.cells[i], b.cells[i])
+	}
+	return res, nil
+}
+
+// elementWiseInPlace store `f` applied to each pair of cells of `a` and `b` into `a`
+func elementWiseInPlace[N Number](a, b *Matrix[N], f func(x, y N) N) error {
+	if err := checkShapes(a, b); err != nil {
+		return err
+	}
+
+	for i := range a.cells {
+		a.cells[i] = f(a.cells[i], b.cells[i])
+	}
+	return nil
+}
+
+// checkDivisors check no cell of `m` is zero
+func checkDivisors[N Number](m *Matrix[N]) error {
+	var zero N
+	for _, cell := range m.cells {
+		if cell == zero {
+			return ErrDivisionByZero
+		}
+	}
+	return nil
+}
+
+func add[N Number](x, y N) N { return x + y }
+func sub[N Number](x, y N) N { return x - y }
+func mul[N Number](x, y N) N { return x * y }
+func div[N Number](x, y N) N { return x / y }
+
+// Add make new matrix with sum of `a` and `b`
+func Add[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	return elementWise(a, b, add[N])
+}
+
+// AddInPlace add `b` to `a`
+func AddInPlace[N Number](a, b *Matrix[N]) error {
+	return elementWiseInPlace(a, b, add[N])
+}
+
+// Sub make new matrix with difference of `a` and `b`
+func Sub[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	return elementWise(a, b, sub[N])
+}
+
+// SubInPlace subtract `b` from `a`
+func SubInPlace[N Number](a, b *Matrix[N]) error {
+	return elementWiseInPlace(a, b, sub[N])
+}
+
+// Hadamard make new matrix with element-wise product of `a` and `b`
+func Hadamard[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	return elementWise(a, b, mul[N])
+}
+
+// HadamardInPlace multiply each cell of `a` by the same cell of `b`
+func HadamardInPlace[N Number](a, b *Matrix[N]) error {
+	return elementWiseInPlace(a, b, mul[N])
+}
+
+// DivElem make new matrix with element-wise quotient of `a` and `b`.
+// Return ErrDivisionByZero if any cell of `b` is zero
+func DivElem[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	if err := checkShapes(a, b); err != nil {
+		return nil, err
+	}
+	if err := checkDivisors(b); err != nil {
+		return nil, err
+	}
+	return elementWise(a, b, div[N])
+}
+
+// DivElemInPlace divide each cell of `a` by the same cell of `b`.
+// Return ErrDivisionByZero and keep `a` unchanged if any cell of `b` is zero
+func DivElemInPlace[N Number](a, b *Matrix[N]) error {
+	if err := checkShapes(a, b); err != nil {
+		return err
+	}
+	if err := checkDivisors(b); err != nil {
+		return err
+	}
+	return elementWiseInPlace(a, b, div[N])
+}
+
+// MulScalar make new matrix with each cell of `m` multiplied by `s`
+func MulScalar[N Number](m *Matrix[N], s N) (*Matrix[N], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	res := NewZeroMatrix[N](m.rowCount, m.colCount)
+	for i, cell := range m.cells {
+		res.cells[i] = cell * s
+	}
+	return res, nil
+}
+
+// MulScalarInPlace multiply each cell of `m` by `s`
+func MulScalarInPlace[N Number](m *Matrix[N], s N) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	for i := range m.cells {
+		m.cells[i] *= s
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	_, err := Add(nil, NewZeroMatrix[int](2, 2))
+	if !errors.Is(err, ErrNilMatrix) {
+		t.Fatal("check nil object fail")
+	}
+
+	_, err = Add(NewZeroMatrix[int](2, 3), NewZeroMatrix[int](3, 2))
+	var dimErr *DimensionError
+	if !errors.As(err, &dimErr) {
+		t.Fatal("check dimension mismatch fail")
+	}
+	if *dimErr != (DimensionError{2, 3, 3, 2}) {
+		t.Errorf("act: %+v", *dimErr)
+	}
+
+	a, _ := NewMatrix([]int{1, 2, 3, 4, 5, 6}, 2, 3)
+	b, _ := NewMatrix([]int{6, 5, 4, 3, 2, 1}, 2, 3)
+
+	sum, err := Add(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(sum.cells, []int{7, 7, 7, 7, 7, 7}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(a.cells, []int{1, 2, 3, 4, 5, 6}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = AddInPlace(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(a.cells, []int{7, 7, 7, 7, 7, 7}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestSub(t *testing.T) {
+	a, _ := NewMatrix([]float64{1, 2, 3, 4}, 2, 2)
+	b, _ := NewMatrix([]float64{0.5, 0.5, 1, 1}, 2, 2)
+
+	diff, err := Sub(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(diff.cells, []float64{0.5, 1.5, 2, 3}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = SubInPlace(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(a.cells, diff.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = SubInPlace(a, NewZeroMatrix[float64](1, 4)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("check dimension mismatch fail")
+	}
+}
+
+func TestHadamard(t *testing.T) {
+	a, _ := NewMatrix([]complex128{1 + 1i, 2, 3i}, 1, 3)
+	b, _ := NewMatrix([]complex128{1 - 1i, 2, 1i}, 1, 3)
+
+	prod, err := Hadamard(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(prod.cells, []complex128{2, 4, -3}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = HadamardInPlace(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(a.cells, prod.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestDivElem(t *testing.T) {
+	a, _ := NewMatrix([]int{10, 20, 30, 40}, 2, 2)
+	b, _ := NewMatrix([]int{2, 4, 0, 8}, 2, 2)
+
+	_, err := DivElem(a, b)
+	if !errors.Is(err, ErrDivisionByZero) {
+		t.Fatal("check division by zero fail")
+	}
+	err = DivElemInPlace(a, b)
+	if err.Error() != DivisionByZero {
+		t.Fatal("check division by zero fail")
+	}
+	if cmpRes := compareSlices(a.cells, []int{10, 20, 30, 40}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	b.Set(1, 0, 3)
+	quot, err := DivElem(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(quot.cells, []int{5, 5, 10, 5}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = DivElemInPlace(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(a.cells, quot.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestMulScalar(t *testing.T) {
+	var m *Matrix[uint8]
+	_, err := MulScalar(m, 2)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if err = MulScalarInPlace(m, 2); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]uint8{1, 2, 3, 4}, 2, 2)
+	prod, err := MulScalar(m, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(prod.cells, []uint8{3, 6, 9, 12}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = MulScalarInPlace(m, 3); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, prod.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,188 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rule get next state of `cell` from its neighbours. `neighbors` slice is reused
+// between calls and must not be retained
+type Rule[T any] func(cell T, neighbors []Neighbor[T]) T
+
+// StepOptions control computing of the next generation
+type StepOptions[T any] struct {
+	// Neighborhood passed to rule, nil means Moore(1)
+	Neighborhood Neighborhood
+	// Edge policy, EdgeWrap make toroidal board and EdgeClip or EdgeConstant make bounded one
+	Edge EdgePolicy
+	// Constant value of cells outside board with EdgeConstant
+	Constant T
+	// Workers is count of goroutines computing row stripes, values below 2 mean sequential step
+	Workers int
+}
+
+// Step make new matrix with the next generation of `m` by `rule`
+func Step[T any](m *Matrix[T], rule Rule[T], opts StepOptions[T]) (*Matrix[T], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+	if !opts.Edge.valid() {
+		return nil, ErrInvalidArgument
+	}
+
+	next := NewZeroMatrix[T](m.rowCount, m.colCount)
+	step(m, next, rule, opts)
+
+	return next, nil
+}
+
+// step write the next generation of `src` into `dst` of the same size
+func step[T any](src, dst *Matrix[T], rule Rule[T], opts StepOptions[T]) {
+	kind := opts.Neighborhood
+	if kind == nil {
+		kind = Moore(1)
+	}
+
+	runStripes(src.rowCount, opts.Workers, func(from, to int) {
+		neighbors := make([]Neighbor[T], 0, len(kind))
+		for row := from; row < to; row++ {
+			for col := 0; col < src.colCount; col++ {
+				neighbors = src.appendNeighbors(neighbors[:0], row, col, kind, opts.Edge, opts.Constant)
+				i := calcIndex(row, col, src.colCount)
+				dst.cells[i] = rule(src.cells[i], neighbors)
+			}
+		}
+	})
+}
+
+// Automaton is a cellular automaton keeping two buffers, so stepping
+// does not allocate a matrix per generation
+type Automaton[T any] struct {
+	current    *Matrix[T]
+	next       *Matrix[T]
+	rule       Rule[T]
+	opts       StepOptions[T]
+	generation int
+}
+
+// NewAutomaton create automaton with copy of `m` as initial generation
+func NewAutomaton[T any](m *Matrix[T], rule Rule[T], opts StepOptions[T]) (*Automaton[T], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+	if !opts.Edge.valid() {
+		return nil, ErrInvalidArgument
+	}
+
+	return &Automaton[T]{
+		current: m.clone(),
+		next:    NewZeroMatrix[T](m.rowCount, m.colCount),
+		rule:    rule,
+		opts:    opts,
+	}, nil
+}
+
+// Step advance automaton to the next generation
+func (a *Automaton[T]) Step() {
+	step(a.current, a.next, a.rule, a.opts)
+	a.current, a.next = a.next, a.current
+	a.generation++
+}
+
+// Run advance automaton by `n` generations
+func (a *Automaton[T]) Run(n int) {
+	for i := 0; i < n; i++ {
+		a.Step()
+	}
+}
+
+// Current get current generation. Matrix is owned by automaton and is overwritten
+// by the second Step after this call, clone it to keep
+func (a *Automaton[T]) Current() *Matrix[T] {
+	return a.current
+}
+
+// Generation get count of steps made
+func (a *Automaton[T]) Generation() int {
+	return a.generation
+}
+
+// LifeRule is a Life-like rule: dead cell becomes alive if count of alive
+// neighbours is in Birth, alive cell survives if the count is in Survival
+type LifeRule struct {
+	Birth    [9]bool
+	Survival [9]bool
+}
+
+// ParseLifeRule parse rule in B/S notation, e.g. "B3/S23" for Conway's Game of Life
+func ParseLifeRule(s string) (LifeRule, error) {
+	var r LifeRule
+	parts := strings.Split(strings.ToUpper(strings.TrimSpace(s)), "/")
+	if len(parts) != 2 {
+		return r, ErrInvalidArgument
+	}
+
+	seen := map[byte]bool{}
+	for _, part := range parts {
+		if part == "" || seen[part[0]] {
+			return r, ErrInvalidArgument
+		}
+		seen[part[0]] = true
+
+		var counts *[9]bool
+		switch part[0] {
+		case 'B':
+			counts = &r.Birth
+		case 'S':
+			counts = &r.Survival
+		default:
+			return r, ErrInvalidArgument
+		}
+		for _, c := range part[1:] {
+			if c < '0' || c > '8' {
+				return r, ErrInvalidArgument
+			}
+			counts[c-'0'] = true
+		}
+	}
+
+	return r, nil
+}
+
+// String get rule in B/S notation
+func (r LifeRule) String() string {
+	var b strings.Builder
+	b.WriteString("B")
+	for n, ok := range r.Birth {
+		if ok {
+			fmt.Fprint(&b, n)
+		}
+	}
+	b.WriteString("/S")
+	for n, ok := range r.Survival {
+		if ok {
+			fmt.Fprint(&b, n)
+		}
+	}
+	return b.String()
+}
+
+// Rule make automaton rule for boolean cells where true is alive.
+// Counts of alive neighbours above 8 never give birth or survival
+func (r LifeRule) Rule() Rule[bool] {
+	return func(cell bool, neighbors []Neighbor[bool]) bool {
+		alive := 0
+		for _, n := range neighbors {
+			if n.Value {
+				alive++
+			}
+		}
+		if alive >= len(r.Birth) {
+			return false
+		}
+		if cell {
+			return r.Survival[alive]
+		}
+		return r.Birth[alive]
+	}
+}
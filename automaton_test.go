@@ -0,0 +1,210 @@
+package matrix
+
+import (
+	"math/rand"
+	"runtime"
+	"testing"
+)
+
+func boardFromRows(rows ...string) *Matrix[bool] {
+	m := NewZeroMatrix[bool](len(rows), len(rows[0]))
+	for row, s := range rows {
+		for col := range s {
+			m.cells[row*m.colCount+col] = s[col] == '#'
+		}
+	}
+	return m
+}
+
+func TestParseLifeRule(t *testing.T) {
+	r, err := ParseLifeRule("B3/S23")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Birth[3] || r.Birth[2] || !r.Survival[2] || !r.Survival[3] || r.Survival[4] {
+		t.Errorf("act: %v", r)
+	}
+	if s := r.String(); s != "B3/S23" {
+		t.Errorf("act: %s exp: B3/S23", s)
+	}
+
+	r, err = ParseLifeRule(" s23/b36 ")
+	if err != nil || r.String() != "B36/S23" {
+		t.Errorf("act: %v, %v exp: B36/S23", r, err)
+	}
+	r, err = ParseLifeRule("B2/S")
+	if err != nil || r.String() != "B2/S" {
+		t.Errorf("act: %v, %v exp: B2/S", r, err)
+	}
+
+	for _, s := range []string{"", "B3", "B3/S23/X", "B9/S23", "B3/B23", "X3/S23", "B3/", "B3a/S23"} {
+		if _, err := ParseLifeRule(s); err == nil || err.Error() != InvalidArgument {
+			t.Errorf("%q: act: %v exp: %s", s, err, InvalidArgument)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	life, _ := ParseLifeRule("B3/S23")
+
+	if _, err := Step(nil, life.Rule(), StepOptions[bool]{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if _, err := Step(NewZeroMatrix[bool](2, 2), life.Rule(), StepOptions[bool]{Edge: 9}); err.Error() != InvalidArgument {
+		t.Error("check invalid edge policy fail")
+	}
+
+	blinker := boardFromRows(
+		".....",
+		"..#..",
+		"..#..",
+		"..#..",
+		".....")
+	next, err := Step(blinker, life.Rule(), StepOptions[bool]{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := boardFromRows(
+		".....",
+		".....",
+		".###.",
+		".....",
+		".....")
+	if cmpRes := compareSlices(next.cells, exp.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if blinker.cells[7] != true || blinker.cells[11] != false {
+		t.Error("source matrix modified")
+	}
+
+	// bounded board kills blinker at the edge, torus keeps it
+	edge := boardFromRows(
+		"###",
+		"...",
+		"...",
+		"...")
+	next, _ = Step(edge, life.Rule(), StepOptions[bool]{Edge: EdgeClip})
+	exp = boardFromRows(
+		".#.",
+		".#.",
+		"...",
+		"...")
+	if cmpRes := compareSlices(next.cells, exp.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	next, _ = Step(next, life.Rule(), StepOptions[bool]{Edge: EdgeClip})
+	if count, _ := next.CountIf(func(cell bool) bool { return cell }); count != 0 {
+		t.Errorf("act: %d alive exp: 0", count)
+	}
+
+	wide := boardFromRows(
+		".....",
+		".###.",
+		".....",
+		".....")
+	next, _ = Step(wide, life.Rule(), StepOptions[bool]{Edge: EdgeWrap})
+	exp = boardFromRows(
+		"..#..",
+		"..#..",
+		"..#..",
+		".....")
+	if cmpRes := compareSlices(next.cells, exp.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestAutomaton(t *testing.T) {
+	life, _ := ParseLifeRule("B3/S23")
+	if _, err := NewAutomaton(nil, life.Rule(), StepOptions[bool]{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	// glider on torus returns to start after 4 generations per cell of board side
+	glider := boardFromRows(
+		".#......",
+		"..#.....",
+		"###.....",
+		"........",
+		"........",
+		"........",
+		"........",
+		"........")
+	a, err := NewAutomaton(glider, life.Rule(), StepOptions[bool]{Edge: EdgeWrap})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Step()
+	if a.Generation() != 1 {
+		t.Errorf("act: %d exp: 1", a.Generation())
+	}
+	if cmpRes := compareSlices(a.Current().cells, glider.cells); cmpRes == nil {
+		t.Error("glider did not move")
+	}
+	a.Run(31)
+	if a.Generation() != 32 {
+		t.Errorf("act: %d exp: 32", a.Generation())
+	}
+	if cmpRes := compareSlices(a.Current().cells, glider.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	// parallel step gives the same result
+	r := rand.New(rand.NewSource(3))
+	board := NewZeroMatrix[bool](37, 29)
+	for i := range board.cells {
+		board.cells[i] = r.Intn(3) == 0
+	}
+	seq, _ := NewAutomaton(board, life.Rule(), StepOptions[bool]{Edge: EdgeWrap})
+	par, _ := NewAutomaton(board, life.Rule(), StepOptions[bool]{Edge: EdgeWrap, Workers: 4})
+	seq.Run(10)
+	par.Run(10)
+	if cmpRes := compareSlices(par.Current().cells, seq.Current().cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestCustomRule(t *testing.T) {
+	// sum of von Neumann neighbours with constant border
+	sum := func(cell int, neighbors []Neighbor[int]) int {
+		for _, n := range neighbors {
+			cell += n.Value
+		}
+		return cell
+	}
+	m, _ := NewMatrix([]int{
+		1, 2,
+		3, 4}, 2, 2)
+	next, err := Step(m, sum, StepOptions[int]{Neighborhood: VonNeumann(1), Edge: EdgeConstant, Constant: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []int{
+		26, 27,
+		28, 29}
+	if cmpRes := compareSlices(next.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func benchmarkAutomaton(b *testing.B, workers int) {
+	life, _ := ParseLifeRule("B3/S23")
+	r := rand.New(rand.NewSource(1))
+	board := NewZeroMatrix[bool](256, 256)
+	for i := range board.cells {
+		board.cells[i] = r.Intn(2) == 0
+	}
+	a, _ := NewAutomaton(board, life.Rule(), StepOptions[bool]{Edge: EdgeWrap, Workers: workers})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Step()
+	}
+}
+
+func BenchmarkAutomatonStep(b *testing.B) {
+	benchmarkAutomaton(b, 1)
+}
+
+func BenchmarkAutomatonStepParallel(b *testing.B) {
+	benchmarkAutomaton(b, runtime.GOMAXPROCS(0))
+}
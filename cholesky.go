@@ -0,0 +1,101 @@
+package matrix
+
+// Cholesky is a factorization A = LLᵀ of symmetric positive definite matrix A,
+// where L is lower triangular with positive diagonal
+type Cholesky[F Float] struct {
+	l *Matrix[F]
+}
+
+// NewCholesky factorize symmetric positive definite matrix `m`.
+// Return NotPositiveDefiniteError for other matrices
+func NewCholesky[F Float](m *Matrix[F]) (*Cholesky[F], error) {
+	if err := checkSquare(m); err != nil {
+		return nil, err
+	}
+
+	n := m.rowCount
+	a := m.cells
+	tol := F(n) * epsilon[F]() * maxAbs(a)
+
+	l := NewZeroMatrix[F](n, n)
+	for j := 0; j < n; j++ {
+		for i := 0; i < j; i++ {
+			if abs(a[i*n+j]-a[j*n+i]) > tol {
+				return nil, &NotPositiveDefiniteError{Column: j}
+			}
+		}
+
+		d := a[j*n+j]
+		for k := 0; k < j; k++ {
+			d -= l.cells[j*n+k] * l.cells[j*n+k]
+		}
+		if d <= tol {
+			return nil, &NotPositiveDefiniteError{Column: j}
+		}
+		d = sqrt(d)
+		l.cells[j*n+j] = d
+
+		for i := j + 1; i < n; i++ {
+			s := a[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l.cells[i*n+k] * l.cells[j*n+k]
+			}
+			l.cells[i*n+j] = s / d
+		}
+	}
+
+	return &Cholesky[F]{l}, nil
+}
+
+// L get lower triangular factor
+func (f *Cholesky[F]) L() *Matrix[F] {
+	return f.l.clone()
+}
+
+// Det get determinant of factorized matrix
+func (f *Cholesky[F]) Det() F {
+	n := f.l.rowCount
+	var det F = 1
+	for i := 0; i < n; i++ {
+		det *= f.l.cells[i*n+i]
+	}
+	return det * det
+}
+
+// Solve get X for AX = `b`
+func (f *Cholesky[F]) Solve(b *Matrix[F]) (*Matrix[F], error) {
+	if b == nil {
+		return nil, ErrNilMatrix
+	}
+	if b.rowCount != f.l.rowCount {
+		return nil, dimensionError(f.l, b)
+	}
+
+	n, cols := f.l.rowCount, b.colCount
+	l := f.l.cells
+	x := b.clone()
+
+	for c := 0; c < cols; c++ {
+		for i := 0; i < n; i++ {
+			sum := x.cells[i*cols+c]
+			for k := 0; k < i; k++ {
+				sum -= l[i*n+k] * x.cells[k*cols+c]
+			}
+			x.cells[i*cols+c] = sum / l[i*n+i]
+		}
+		for i := n - 1; i >= 0; i-- {
+			sum := x.cells[i*cols+c]
+			for k := i + 1; k < n; k++ {
+				sum -= l[k*n+i] * x.cells[k*cols+c]
+			}
+			x.cells[i*cols+c] = sum / l[i*n+i]
+		}
+	}
+
+	return x, nil
+}
+
+// Inverse get inverse of factorized matrix
+func (f *Cholesky[F]) Inverse() (*Matrix[F], error) {
+	return f.Solve(Identity[F](f.l.rowCount))
+}
@@ -0,0 +1,96 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCholesky(t *testing.T) {
+	_, err := NewCholesky(NewZeroMatrix[float64](2, 3))
+	if err.Error() != NotSquareMatrix {
+		t.Fatal("check not square fail")
+	}
+
+	m, _ := NewMatrix([]float64{
+		4, 12, -16,
+		12, 37, -43,
+		-16, -43, 98}, 3, 3)
+	f, err := NewCholesky(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []float64{
+		2, 0, 0,
+		6, 1, 0,
+		-8, 5, 3}
+	if cmpRes := compareFloats(f.L().cells, exp, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if det := f.Det(); math.Abs(det-36) > 1e-9 {
+		t.Errorf("act: %v exp: 36", det)
+	}
+
+	inv, err := f.Inverse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := Mul(m, inv)
+	if cmpRes := compareFloats(id.cells, Identity[float64](3).cells, 1e-9); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestCholeskyNotPositiveDefinite(t *testing.T) {
+	test := func(name string, d []float64, column int) {
+		t.Run(name, func(t *testing.T) {
+			m, _ := NewMatrix(d, 2, 2)
+			_, err := NewCholesky(m)
+			if !errors.Is(err, ErrNotPositiveDefinite) {
+				t.Fatalf("act: %v exp: %v", err, ErrNotPositiveDefinite)
+			}
+			var npdErr *NotPositiveDefiniteError
+			if !errors.As(err, &npdErr) || npdErr.Column != column {
+				t.Errorf("act: %v exp: column %d", err, column)
+			}
+		})
+	}
+
+	test("negative", []float64{-1, 0, 0, 1}, 0)
+	test("indefinite", []float64{1, 2, 2, 1}, 1)
+	test("asymmetric", []float64{2, 1, 0, 2}, 1)
+}
+
+func TestCholeskySolve(t *testing.T) {
+	m, _ := NewMatrix([]float32{
+		2, -1, 0,
+		-1, 2, -1,
+		0, -1, 2}, 3, 3)
+	f, err := NewCholesky(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.Solve(NewZeroMatrix[float32](2, 2))
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("check dimension mismatch fail")
+	}
+
+	b, _ := NewMatrix([]float32{
+		1, 0,
+		0, 0,
+		1, 4}, 3, 2)
+	x, err := f.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bx, _ := Mul(m, x)
+	if cmpRes := compareFloats(bx.cells, b.cells, 1e-5); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareFloats([]float32{x.cells[0], x.cells[2], x.cells[4]}, []float32{1, 1, 1}, 1e-5); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,128 @@
+package matrix
+
+// Connectivity is a kind of cell adjacency
+type Connectivity int
+
+// Connectivity kinds
+const (
+	// Four connect cells sharing a side
+	Four Connectivity = 4
+	// Eight connect cells sharing a side or a corner
+	Eight Connectivity = 8
+)
+
+// offsets get offsets of cells adjacent by connectivity `c`
+func (c Connectivity) offsets() ([]Point, error) {
+	switch c {
+	case Four:
+		return []Point{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}, nil
+	case Eight:
+		return []Point{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}, nil
+	}
+	return nil, ErrInvalidArgument
+}
+
+// ComponentInfo describe connected region of cells
+type ComponentInfo struct {
+	Label  int
+	Size   int
+	Bounds Rect
+}
+
+// walk visit every cell reachable from cell with slice index `from` through
+// adjacent cells accepted by `next`. `visited` is shared between calls and
+// updated, `f` is called once per visited cell. Cells are kept in explicit
+// stack, so large regions do not exhaust call stack
+func (m *Matrix[T]) walk(from int, offsets []Point, visited []bool, next func(from, to int) bool, f func(i int)) {
+	stack := []int{from}
+	visited[from] = true
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		f(i)
+
+		row, col, _ := m.pos(i)
+		for _, offset := range offsets {
+			j, err := m.index(row+offset.Row, col+offset.Column)
+			if err != nil || visited[j] || !next(i, j) {
+				continue
+			}
+			visited[j] = true
+			stack = append(stack, j)
+		}
+	}
+}
+
+// FloodFill set `value` to every cell satisfying `pred` and connected to `start`
+// by sides through such cells. Return count of filled cells, which is 0 if
+// `start` itself does not satisfy `pred`
+func (m *Matrix[T]) FloodFill(start Point, pred func(T) bool, value T) (int, error) {
+	if m == nil {
+		return 0, ErrNilMatrix
+	}
+
+	from, err := m.index(start.Row, start.Column)
+	if err != nil {
+		return 0, err
+	}
+	if !pred(m.cells[from]) {
+		return 0, nil
+	}
+
+	offsets, _ := Four.offsets()
+	region := make([]int, 0)
+	m.walk(from, offsets, make([]bool, len(m.cells)),
+		func(_, to int) bool { return pred(m.cells[to]) },
+		func(i int) { region = append(region, i) })
+
+	for _, i := range region {
+		m.cells[i] = value
+	}
+
+	return len(region), nil
+}
+
+// Components label connected regions of cells, where adjacent cells belong to
+// the same region if `eq` reports them equal. Return matrix of labels of the same
+// size and info for each region. Labels start from 0 and follow row-major order
+// of the first cell of each region
+func (m *Matrix[T]) Components(eq func(a, b T) bool, connectivity Connectivity) (*Matrix[int], []ComponentInfo, error) {
+	if m == nil {
+		return nil, []ComponentInfo{}, ErrNilMatrix
+	}
+
+	offsets, err := connectivity.offsets()
+	if err != nil {
+		return nil, []ComponentInfo{}, err
+	}
+
+	labels := NewZeroMatrix[int](m.rowCount, m.colCount)
+	infos := make([]ComponentInfo, 0)
+	visited := make([]bool, len(m.cells))
+	for from := range m.cells {
+		if visited[from] {
+			continue
+		}
+
+		row, col, _ := m.pos(from)
+		top, left, bottom, right := row, col, row, col
+		size := 0
+		m.walk(from, offsets, visited,
+			func(i, j int) bool { return eq(m.cells[i], m.cells[j]) },
+			func(i int) {
+				labels.cells[i] = len(infos)
+				size++
+				row, col, _ := m.pos(i)
+				top, left = min(top, row), min(left, col)
+				bottom, right = max(bottom, row), max(right, col)
+			})
+
+		infos = append(infos, ComponentInfo{
+			Label:  len(infos),
+			Size:   size,
+			Bounds: Rect{top, left, bottom - top + 1, right - left + 1},
+		})
+	}
+
+	return labels, infos, nil
+}
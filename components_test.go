@@ -0,0 +1,124 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestFloodFill(t *testing.T) {
+	var m *Matrix[int]
+	zero := func(cell int) bool { return cell == 0 }
+	if _, err := m.FloodFill(Point{0, 0}, zero, 1); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		0, 0, 1, 0,
+		1, 0, 1, 0,
+		0, 1, 0, 0,
+		0, 1, 0, 1}, 4, 4)
+
+	if _, err := m.FloodFill(Point{4, 0}, zero, 2); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	count, err := m.FloodFill(Point{0, 2}, zero, 2)
+	if err != nil || count != 0 {
+		t.Errorf("act: %d, %v exp: 0", count, err)
+	}
+
+	count, err = m.FloodFill(Point{0, 0}, zero, 2)
+	if err != nil || count != 3 {
+		t.Errorf("act: %d, %v exp: 3", count, err)
+	}
+	exp := []int{
+		2, 2, 1, 0,
+		1, 2, 1, 0,
+		0, 1, 0, 0,
+		0, 1, 0, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	// filled value still satisfies predicate
+	count, _ = m.FloodFill(Point{3, 2}, func(cell int) bool { return cell != 1 }, 0)
+	if count != 5 {
+		t.Errorf("act: %d exp: 5", count)
+	}
+	exp = []int{
+		2, 2, 1, 0,
+		1, 2, 1, 0,
+		0, 1, 0, 0,
+		0, 1, 0, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	big := NewZeroMatrix[int](1000, 1000)
+	count, _ = big.FloodFill(Point{500, 500}, zero, 1)
+	if count != 1000*1000 {
+		t.Errorf("act: %d exp: %d", count, 1000*1000)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	var m *Matrix[int]
+	if _, _, err := m.Components(eq, Four); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 1, 0, 2,
+		0, 1, 2, 2,
+		3, 0, 0, 2}, 3, 4)
+
+	if _, _, err := m.Components(eq, Connectivity(6)); err.Error() != InvalidArgument {
+		t.Error("check invalid connectivity fail")
+	}
+
+	labels, infos, err := m.Components(eq, Four)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []int{
+		0, 0, 1, 2,
+		3, 0, 2, 2,
+		4, 5, 5, 2}
+	if cmpRes := compareSlices(labels.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	expInfos := []ComponentInfo{
+		{0, 3, Rect{0, 0, 2, 2}},
+		{1, 1, Rect{0, 2, 1, 1}},
+		{2, 4, Rect{0, 2, 3, 2}},
+		{3, 1, Rect{1, 0, 1, 1}},
+		{4, 1, Rect{2, 0, 1, 1}},
+		{5, 2, Rect{2, 1, 1, 2}},
+	}
+	if cmpRes := compareSlices(infos, expInfos); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	labels, infos, err = m.Components(eq, Eight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp = []int{
+		0, 0, 1, 2,
+		3, 0, 2, 2,
+		4, 3, 3, 2}
+	if cmpRes := compareSlices(labels.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	expInfos = []ComponentInfo{
+		{0, 3, Rect{0, 0, 2, 2}},
+		{1, 1, Rect{0, 2, 1, 1}},
+		{2, 4, Rect{0, 2, 3, 2}},
+		{3, 3, Rect{1, 0, 2, 3}},
+		{4, 1, Rect{2, 0, 1, 1}},
+	}
+	if cmpRes := compareSlices(infos, expInfos); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
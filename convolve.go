@@ -0,0 +1,226 @@
+package matrix
+
+import (
+	"math"
+	"slices"
+)
+
+// Padding is an output mode of convolution
+type Padding int
+
+// Padding modes
+const (
+	// PaddingSame make output of input size with kernel anchored at
+	// cell [(rows-1)/2, (columns-1)/2]
+	PaddingSame Padding = iota
+	// PaddingValid make output only where kernel fits inside input
+	PaddingValid
+	// PaddingFull make output wherever kernel overlaps input
+	PaddingFull
+)
+
+// ConvOptions control convolution and correlation
+type ConvOptions struct {
+	// Padding is an output mode
+	Padding Padding
+	// Stride take every Stride-th output row and column, zero means 1
+	Stride int
+	// Edge policy for input cells outside matrix, EdgeClip treat them as zeros
+	Edge EdgePolicy
+	// Constant value of input cells outside matrix with EdgeConstant
+	Constant float64
+}
+
+// convGeometry describe mapping of output cells to input cells:
+// output [i, j] starts at input [i*stride-offRow, j*stride-offCol]
+type convGeometry struct {
+	rows, cols     int
+	offRow, offCol int
+	stride         int
+}
+
+func newConvGeometry(m *Matrix[float64], kRows, kCols int, opts ConvOptions) (convGeometry, error) {
+	if kRows == 0 || kCols == 0 {
+		return convGeometry{}, ErrInvalidSize
+	}
+	if opts.Stride < 0 || !opts.Edge.valid() {
+		return convGeometry{}, ErrInvalidArgument
+	}
+
+	g := convGeometry{stride: max(opts.Stride, 1)}
+	switch opts.Padding {
+	case PaddingSame:
+		g.rows, g.cols = m.rowCount, m.colCount
+		g.offRow, g.offCol = (kRows-1)/2, (kCols-1)/2
+	case PaddingValid:
+		g.rows, g.cols = m.rowCount-kRows+1, m.colCount-kCols+1
+	case PaddingFull:
+		g.rows, g.cols = m.rowCount+kRows-1, m.colCount+kCols-1
+		g.offRow, g.offCol = kRows-1, kCols-1
+	default:
+		return convGeometry{}, ErrInvalidArgument
+	}
+	if g.rows <= 0 || g.cols <= 0 {
+		return convGeometry{}, &DimensionError{Rows: m.rowCount, Columns: m.colCount, OtherRows: kRows, OtherColumns: kCols}
+	}
+
+	g.rows = (g.rows + g.stride - 1) / g.stride
+	g.cols = (g.cols + g.stride - 1) / g.stride
+
+	return g, nil
+}
+
+// sample get input cell [row, col] resolved by edge policy
+func sample(m *Matrix[float64], row, col int, opts ConvOptions) float64 {
+	i, ok := m.resolveIndex(row, col, opts.Edge)
+	if ok {
+		return m.cells[i]
+	}
+	if opts.Edge == EdgeConstant {
+		return opts.Constant
+	}
+	return 0
+}
+
+// Correlate make new matrix with cross-correlation of `m` and `kernel`
+func Correlate(m, kernel *Matrix[float64], opts ConvOptions) (*Matrix[float64], error) {
+	if m == nil || kernel == nil {
+		return nil, ErrNilMatrix
+	}
+
+	g, err := newConvGeometry(m, kernel.rowCount, kernel.colCount, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := NewZeroMatrix[float64](g.rows, g.cols)
+	for i := 0; i < g.rows; i++ {
+		for j := 0; j < g.cols; j++ {
+			row, col := i*g.stride-g.offRow, j*g.stride-g.offCol
+			sum := 0.0
+			for a := 0; a < kernel.rowCount; a++ {
+				for b := 0; b < kernel.colCount; b++ {
+					sum += kernel.cells[a*kernel.colCount+b] * sample(m, row+a, col+b, opts)
+				}
+			}
+			res.cells[i*g.cols+j] = sum
+		}
+	}
+
+	return res, nil
+}
+
+// Convolve make new matrix with convolution of `m` and `kernel`,
+// which is correlation with kernel rotated to 180 grad
+func Convolve(m, kernel *Matrix[float64], opts ConvOptions) (*Matrix[float64], error) {
+	if m == nil || kernel == nil {
+		return nil, ErrNilMatrix
+	}
+
+	flipped := kernel.clone()
+	err := flipped.Rotate180()
+	if err != nil {
+		return nil, err
+	}
+
+	return Correlate(m, flipped, opts)
+}
+
+// ConvolveSeparable make new matrix with convolution of `m` and kernel equal to
+// outer product of vertical `col` and horizontal `row` vectors. It takes
+// len(col)+len(row) multiplications per cell instead of len(col)*len(row)
+func ConvolveSeparable(m *Matrix[float64], col, row []float64, opts ConvOptions) (*Matrix[float64], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	col, row = slices.Clone(col), slices.Clone(row)
+	slices.Reverse(col)
+	slices.Reverse(row)
+
+	return CorrelateSeparable(m, col, row, opts)
+}
+
+// CorrelateSeparable make new matrix with cross-correlation of `m` and kernel
+// equal to outer product of vertical `col` and horizontal `row` vectors
+func CorrelateSeparable(m *Matrix[float64], col, row []float64, opts ConvOptions) (*Matrix[float64], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	g, err := newConvGeometry(m, len(col), len(row), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// horizontal pass over every input row touched by kernel, rows outside
+	// matrix are resolved by edge policy inside sample
+	tmpRows := (g.rows-1)*g.stride + len(col)
+	tmp := make([]float64, tmpRows*g.cols)
+	for r := 0; r < tmpRows; r++ {
+		for j := 0; j < g.cols; j++ {
+			start := j*g.stride - g.offCol
+			sum := 0.0
+			for b, k := range row {
+				sum += k * sample(m, r-g.offRow, start+b, opts)
+			}
+			tmp[r*g.cols+j] = sum
+		}
+	}
+
+	res := NewZeroMatrix[float64](g.rows, g.cols)
+	for i := 0; i < g.rows; i++ {
+		for j := 0; j < g.cols; j++ {
+			sum := 0.0
+			for a, k := range col {
+				sum += k * tmp[(i*g.stride+a)*g.cols+j]
+			}
+			res.cells[i*g.cols+j] = sum
+		}
+	}
+
+	return res, nil
+}
+
+// Separate split rank one `kernel` into vertical and horizontal vectors whose
+// outer product is equal to kernel within relative tolerance `tol`.
+// Return false if kernel is not separable
+func Separate(kernel *Matrix[float64], tol float64) (col, row []float64, ok bool, err error) {
+	if kernel == nil {
+		return nil, nil, false, ErrNilMatrix
+	}
+	if len(kernel.cells) == 0 {
+		return nil, nil, false, ErrInvalidSize
+	}
+
+	pivot := 0
+	for i, cell := range kernel.cells {
+		if math.Abs(cell) > math.Abs(kernel.cells[pivot]) {
+			pivot = i
+		}
+	}
+	scale := kernel.cells[pivot]
+	if scale == 0 {
+		return make([]float64, kernel.rowCount), make([]float64, kernel.colCount), true, nil
+	}
+
+	p, q, _ := kernel.pos(pivot)
+	col = make([]float64, kernel.rowCount)
+	for a := range col {
+		col[a] = kernel.cells[a*kernel.colCount+q]
+	}
+	row = make([]float64, kernel.colCount)
+	for b := range row {
+		row[b] = kernel.cells[p*kernel.colCount+b] / scale
+	}
+
+	for a := range col {
+		for b := range row {
+			if math.Abs(col[a]*row[b]-kernel.cells[a*kernel.colCount+b]) > tol*math.Abs(scale) {
+				return nil, nil, false, nil
+			}
+		}
+	}
+
+	return col, row, true, nil
+}
@@ -0,0 +1,188 @@
+package matrix
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func convSample() *Matrix[float64] {
+	m, _ := NewMatrix([]float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	return m
+}
+
+func TestConvolveErrors(t *testing.T) {
+	m := convSample()
+	ones, _ := NewMatrix([]float64{1, 1, 1, 1}, 2, 2)
+
+	if _, err := Correlate(nil, ones, ConvOptions{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if _, err := Convolve(m, nil, ConvOptions{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if _, err := ConvolveSeparable(nil, []float64{1}, []float64{1}, ConvOptions{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if _, err := Correlate(m, NewZeroMatrix[float64](0, 0), ConvOptions{}); err.Error() != InvalidMatrixSize {
+		t.Error("check empty kernel fail")
+	}
+	if _, err := Correlate(m, ones, ConvOptions{Stride: -1}); err.Error() != InvalidArgument {
+		t.Error("check negative stride fail")
+	}
+	if _, err := Correlate(m, ones, ConvOptions{Padding: 5}); err.Error() != InvalidArgument {
+		t.Error("check invalid padding fail")
+	}
+	if _, err := Correlate(m, ones, ConvOptions{Edge: 9}); err.Error() != InvalidArgument {
+		t.Error("check invalid edge policy fail")
+	}
+	_, err := Correlate(m, NewZeroMatrix[float64](4, 1), ConvOptions{Padding: PaddingValid})
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("act: %v exp: %v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestCorrelate(t *testing.T) {
+	m := convSample()
+	ones, _ := NewMatrix([]float64{1, 1, 1, 1}, 2, 2)
+
+	test := func(name string, opts ConvOptions, rows, cols int, exp []float64) {
+		act, err := Correlate(m, ones, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act.rowCount != rows || act.colCount != cols {
+			t.Errorf("%s: act: %dx%d exp: %dx%d", name, act.rowCount, act.colCount, rows, cols)
+		}
+		if cmpRes := compareFloats(act.cells, exp, 1e-12); cmpRes != nil {
+			t.Errorf("%s: %v", name, cmpRes)
+		}
+	}
+
+	test("valid", ConvOptions{Padding: PaddingValid}, 2, 2, []float64{
+		12, 16,
+		24, 28})
+	test("same", ConvOptions{Padding: PaddingSame}, 3, 3, []float64{
+		12, 16, 9,
+		24, 28, 15,
+		15, 17, 9})
+	test("full", ConvOptions{Padding: PaddingFull}, 4, 4, []float64{
+		1, 3, 5, 3,
+		5, 12, 16, 9,
+		11, 24, 28, 15,
+		7, 15, 17, 9})
+	test("stride", ConvOptions{Padding: PaddingFull, Stride: 2}, 2, 2, []float64{
+		1, 5,
+		11, 28})
+	test("constant", ConvOptions{Padding: PaddingSame, Edge: EdgeConstant, Constant: 1}, 3, 3, []float64{
+		12, 16, 11,
+		24, 28, 17,
+		17, 19, 12})
+}
+
+func TestCorrelateEdges(t *testing.T) {
+	m, _ := NewMatrix([]float64{1, 2, 3}, 1, 3)
+	kernel, _ := NewMatrix([]float64{1, 1, 1}, 1, 3)
+
+	test := func(edge EdgePolicy, exp []float64) {
+		act, err := Correlate(m, kernel, ConvOptions{Edge: edge, Constant: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmpRes := compareFloats(act.cells, exp, 1e-12); cmpRes != nil {
+			t.Errorf("edge %d: %v", edge, cmpRes)
+		}
+	}
+	test(EdgeClip, []float64{3, 6, 5})
+	test(EdgeWrap, []float64{6, 6, 6})
+	test(EdgeReflect, []float64{5, 6, 7})
+	test(EdgeConstant, []float64{13, 6, 15})
+}
+
+func TestConvolve(t *testing.T) {
+	m := convSample()
+	kernel, _ := NewMatrix([]float64{
+		1, 2,
+		3, 4}, 2, 2)
+
+	act, err := Convolve(m, kernel, ConvOptions{Padding: PaddingValid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []float64{
+		23, 33,
+		53, 63}
+	if cmpRes := compareFloats(act.cells, exp, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(kernel.cells, []float64{1, 2, 3, 4}); cmpRes != nil {
+		t.Error("kernel modified:", cmpRes)
+	}
+}
+
+func TestSeparable(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	m := NewZeroMatrix[float64](7, 9)
+	for i := range m.cells {
+		m.cells[i] = r.Float64()*2 - 1
+	}
+	col, row := []float64{1, -2, 0.5}, []float64{0.25, 3, -1, 2}
+	kernel := NewZeroMatrix[float64](len(col), len(row))
+	for a := range col {
+		for b := range row {
+			kernel.cells[a*len(row)+b] = col[a] * row[b]
+		}
+	}
+
+	for _, padding := range []Padding{PaddingSame, PaddingValid, PaddingFull} {
+		for _, edge := range []EdgePolicy{EdgeClip, EdgeWrap, EdgeReflect, EdgeConstant} {
+			for stride := 0; stride <= 3; stride++ {
+				opts := ConvOptions{Padding: padding, Stride: stride, Edge: edge, Constant: -3}
+				exp, err := Convolve(m, kernel, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act, err := ConvolveSeparable(m, col, row, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if act.rowCount != exp.rowCount || act.colCount != exp.colCount {
+					t.Fatalf("%v: act: %dx%d exp: %dx%d", opts, act.rowCount, act.colCount, exp.rowCount, exp.colCount)
+				}
+				if cmpRes := compareFloats(act.cells, exp.cells, 1e-9); cmpRes != nil {
+					t.Errorf("%v: %v", opts, cmpRes)
+				}
+			}
+		}
+	}
+	if col[0] != 1 || row[0] != 0.25 {
+		t.Error("kernel vectors modified")
+	}
+
+	sepCol, sepRow, ok, err := Separate(kernel, 1e-12)
+	if err != nil || !ok {
+		t.Fatalf("act: %t, %v exp: separable", ok, err)
+	}
+	back := NewZeroMatrix[float64](len(sepCol), len(sepRow))
+	for a := range sepCol {
+		for b := range sepRow {
+			back.cells[a*len(sepRow)+b] = sepCol[a] * sepRow[b]
+		}
+	}
+	if cmpRes := compareFloats(back.cells, kernel.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if _, _, ok, _ = Separate(SobelX(), 1e-12); !ok {
+		t.Error("SobelX must be separable")
+	}
+	if _, _, ok, _ = Separate(Laplacian(), 1e-12); ok {
+		t.Error("Laplacian must not be separable")
+	}
+	if _, _, _, err = Separate(nil, 1e-12); err.Error() != NilMatrixObject {
+		t.Error("check nil object fail")
+	}
+}
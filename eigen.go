@@ -0,0 +1,116 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+)
+
+// maxSweeps is the iteration limit for Jacobi algorithms
+const maxSweeps = 100
+
+// Eigen get eigenvalues in descending order and matching eigenvectors stored
+// as columns of `vectors` for symmetric matrix `m` using cyclic Jacobi method.
+// Return ErrNoConvergence if off-diagonal part does not vanish in 100 sweeps
+func Eigen(m *Matrix[float64]) (values []float64, vectors *Matrix[float64], err error) {
+	if err := checkSquare(m); err != nil {
+		return nil, nil, err
+	}
+
+	n := m.rowCount
+	a := m.clone().cells
+	tol := float64(n) * epsilon[float64]() * maxAbs(a)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if math.Abs(a[i*n+j]-a[j*n+i]) > tol {
+				return nil, nil, ErrNotSymmetric
+			}
+		}
+	}
+
+	v := Identity[float64](n)
+	converged := offDiagonalVanished(a, n)
+	for sweep := 0; sweep < maxSweeps && !converged; sweep++ {
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a[p*n+q]
+				if apq == 0 {
+					continue
+				}
+				theta := (a[q*n+q] - a[p*n+p]) / (2 * apq)
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				rotateColumns(a, n, n, p, q, c, s)
+				rotateRows(a, n, p, q, c, s)
+				rotateColumns(v.cells, n, n, p, q, c, s)
+			}
+		}
+		converged = offDiagonalVanished(a, n)
+	}
+	if !converged {
+		return nil, nil, ErrNoConvergence
+	}
+
+	values = make([]float64, n)
+	for i := range values {
+		values[i] = a[i*n+i]
+	}
+	order := descendingOrder(values)
+	vectors = NewZeroMatrix[float64](n, n)
+	for j, from := range order {
+		values[j] = a[from*n+from]
+		for i := 0; i < n; i++ {
+			vectors.cells[i*n+j] = v.cells[i*n+from]
+		}
+	}
+
+	return values, vectors, nil
+}
+
+// offDiagonalVanished check if off-diagonal part of n x n `a` is negligible relative to its norm.
+// Each rotation leaves rounding error of order eps*|a|, so tolerance grows with `n`
+func offDiagonalVanished(a []float64, n int) bool {
+	var off, norm float64
+	for i, cell := range a {
+		norm += cell * cell
+		if i/n != i%n {
+			off += cell * cell
+		}
+	}
+	tol := float64(n) * epsilon[float64]()
+	return off <= tol*tol*norm
+}
+
+// rotateColumns apply Givens rotation to columns `p` and `q` of `rows`x`cols` cells
+func rotateColumns(cells []float64, rows, cols, p, q int, c, s float64) {
+	for k := 0; k < rows; k++ {
+		kp, kq := cells[k*cols+p], cells[k*cols+q]
+		cells[k*cols+p] = c*kp - s*kq
+		cells[k*cols+q] = s*kp + c*kq
+	}
+}
+
+// rotateRows apply Givens rotation to rows `p` and `q` of cells with `cols` columns
+func rotateRows(cells []float64, cols, p, q int, c, s float64) {
+	for k := 0; k < cols; k++ {
+		pk, qk := cells[p*cols+k], cells[q*cols+k]
+		cells[p*cols+k] = c*pk - s*qk
+		cells[q*cols+k] = s*pk + c*qk
+	}
+}
+
+// descendingOrder get indices of `values` sorted by value in descending order
+func descendingOrder(values []float64) []int {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return values[order[i]] > values[order[j]]
+	})
+	return order
+}
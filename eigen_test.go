@@ -0,0 +1,119 @@
+package matrix
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestEigenErrors(t *testing.T) {
+	_, _, err := Eigen(nil)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	_, _, err = Eigen(NewZeroMatrix[float64](2, 3))
+	if !errors.Is(err, ErrNotSquare) {
+		t.Error("check not square fail")
+	}
+
+	m, _ := NewMatrix([]float64{1, 2, 3, 4}, 2, 2)
+	_, _, err = Eigen(m)
+	if !errors.Is(err, ErrNotSymmetric) {
+		t.Error("check not symmetric fail")
+	}
+}
+
+func TestEigen(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		4, 1, 2,
+		1, 3, 0,
+		2, 0, 5}, 3, 3)
+
+	values, vectors, err := Eigen(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i < len(values); i++ {
+		if values[i] > values[i-1] {
+			t.Errorf("values are not sorted: %v", values)
+		}
+	}
+
+	var trace float64
+	for _, value := range values {
+		trace += value
+	}
+	if cmpRes := compareFloats([]float64{trace}, []float64{12}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	av, _ := Mul(m, vectors)
+	lambda := NewZeroMatrix[float64](3, 3)
+	for i, value := range values {
+		lambda.Set(i, i, value)
+	}
+	vl, _ := Mul(vectors, lambda)
+	if cmpRes := compareFloats(av.cells, vl.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	vt := vectors.clone()
+	vt.Transpose()
+	vtv, _ := Mul(vt, vectors)
+	if cmpRes := compareFloats(vtv.cells, Identity[float64](3).cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestEigenDiagonal(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		1, 0,
+		0, 3}, 2, 2)
+
+	values, vectors, err := Eigen(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(values, []float64{3, 1}, 0); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareFloats(vectors.cells, []float64{0, 1, 1, 0}, 0); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestEigenLarge(t *testing.T) {
+	const n = 40
+	r := rand.New(rand.NewSource(11))
+	m := NewZeroMatrix[float64](n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			x := r.NormFloat64()
+			m.cells[i*n+j], m.cells[j*n+i] = x, x
+		}
+	}
+
+	values, vectors, err := Eigen(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	av, _ := Mul(m, vectors)
+	lambda := NewZeroMatrix[float64](n, n)
+	for i, value := range values {
+		lambda.Set(i, i, value)
+	}
+	vl, _ := Mul(vectors, lambda)
+	if cmpRes := compareFloats(av.cells, vl.cells, 1e-10); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	vt := vectors.clone()
+	vt.Transpose()
+	vtv, _ := Mul(vt, vectors)
+	if cmpRes := compareFloats(vtv.cells, Identity[float64](n).cells, 1e-10); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,160 @@
+package matrix
+
+import (
+	"errors"
+)
+
+const (
+	InvalidIndexError     = "InvalidIndexError"
+	NilMatrixObject       = "NilMatrixObject"
+	InvalidMatrixSize     = "InvalidMatrixSize"
+	DimensionMismatch     = "DimensionMismatch"
+	DivisionByZero        = "DivisionByZero"
+	NotSquareMatrix       = "NotSquareMatrix"
+	SingularMatrix        = "SingularMatrix"
+	UnderdeterminedSystem = "UnderdeterminedSystem"
+	NotPositiveDefinite   = "NotPositiveDefinite"
+	NotSymmetricMatrix    = "NotSymmetricMatrix"
+	NoConvergence         = "NoConvergence"
+	InvalidArgument       = "InvalidArgument"
+	NoPath                = "NoPath"
+)
+
+var (
+	// ErrInvalidIndex returned when row, column or cell index is out of matrix bounds
+	ErrInvalidIndex = errors.New(InvalidIndexError)
+	// ErrNilMatrix returned when method called on nil matrix object
+	ErrNilMatrix = errors.New(NilMatrixObject)
+	// ErrInvalidSize returned when data length does not match matrix size
+	ErrInvalidSize = errors.New(InvalidMatrixSize)
+	// ErrDimensionMismatch returned when matrices have incompatible shapes
+	ErrDimensionMismatch = errors.New(DimensionMismatch)
+	// ErrDivisionByZero returned when element-wise division meets zero divisor
+	ErrDivisionByZero = errors.New(DivisionByZero)
+	// ErrNotSquare returned when operation requires square matrix
+	ErrNotSquare = errors.New(NotSquareMatrix)
+	// ErrSingularMatrix returned when matrix has no inverse
+	ErrSingularMatrix = errors.New(SingularMatrix)
+	// ErrUnderdetermined returned when linear system has fewer equations than unknowns
+	ErrUnderdetermined = errors.New(UnderdeterminedSystem)
+	// ErrNotPositiveDefinite returned when matrix is not symmetric positive definite
+	ErrNotPositiveDefinite = errors.New(NotPositiveDefinite)
+	// ErrNotSymmetric returned when operation requires symmetric matrix
+	ErrNotSymmetric = errors.New(NotSymmetricMatrix)
+	// ErrNoConvergence returned when iterative algorithm exceeds its iteration limit
+	ErrNoConvergence = errors.New(NoConvergence)
+	// ErrInvalidArgument returned when option or enum value is not supported
+	ErrInvalidArgument = errors.New(InvalidArgument)
+	// ErrNoPath returned when target cell is unreachable
+	ErrNoPath = errors.New(NoPath)
+)
+
+// IndexError describe access to cell out of matrix bounds.
+// For row-only or column-only access unused coordinate is 0.
+// It matches ErrInvalidIndex with errors.Is
+type IndexError struct {
+	Row     int
+	Column  int
+	Rows    int
+	Columns int
+}
+
+// Error return InvalidIndexError for compatibility with string comparison
+func (e *IndexError) Error() string {
+	return InvalidIndexError
+}
+
+// Is report if `target` is ErrInvalidIndex
+func (e *IndexError) Is(target error) bool {
+	return target == ErrInvalidIndex
+}
+
+// SizeError describe data with length not matching matrix size.
+// It matches ErrInvalidSize with errors.Is
+type SizeError struct {
+	Expected int
+	Actual   int
+}
+
+// Error return InvalidMatrixSize for compatibility with string comparison
+func (e *SizeError) Error() string {
+	return InvalidMatrixSize
+}
+
+// Is report if `target` is ErrInvalidSize
+func (e *SizeError) Is(target error) bool {
+	return target == ErrInvalidSize
+}
+
+// DimensionError describe operation on matrices with incompatible shapes.
+// It matches ErrDimensionMismatch with errors.Is
+type DimensionError struct {
+	Rows         int
+	Columns      int
+	OtherRows    int
+	OtherColumns int
+}
+
+// Error return DimensionMismatch for compatibility with string comparison
+func (e *DimensionError) Error() string {
+	return DimensionMismatch
+}
+
+// Is report if `target` is ErrDimensionMismatch
+func (e *DimensionError) Is(target error) bool {
+	return target == ErrDimensionMismatch
+}
+
+// SingularError describe singular matrix detected on factorization.
+// It matches ErrSingularMatrix with errors.Is
+type SingularError struct {
+	// Column is the first column without usable pivot
+	Column int
+}
+
+// Error return SingularMatrix for compatibility with string comparison
+func (e *SingularError) Error() string {
+	return SingularMatrix
+}
+
+// Is report if `target` is ErrSingularMatrix
+func (e *SingularError) Is(target error) bool {
+	return target == ErrSingularMatrix
+}
+
+// NotPositiveDefiniteError describe matrix rejected by Cholesky factorization.
+// It matches ErrNotPositiveDefinite with errors.Is
+type NotPositiveDefiniteError struct {
+	// Column is the first column with non-positive pivot or asymmetric cell
+	Column int
+}
+
+// Error return NotPositiveDefinite for compatibility with string comparison
+func (e *NotPositiveDefiniteError) Error() string {
+	return NotPositiveDefinite
+}
+
+// Is report if `target` is ErrNotPositiveDefinite
+func (e *NotPositiveDefiniteError) Is(target error) bool {
+	return target == ErrNotPositiveDefinite
+}
+
+// dimensionError make DimensionError for matrices `a` and `b` shapes
+func dimensionError[T, U any](a *Matrix[T], b *Matrix[U]) error {
+	return &DimensionError{Rows: a.rowCount, Columns: a.colCount, OtherRows: b.rowCount, OtherColumns: b.colCount}
+}
+
+// cellError make IndexError for cell [row, col]
+func (m *Matrix[T]) cellError(row, col int) error {
+	return &IndexError{Row: row, Column: col, Rows: m.rowCount, Columns: m.colCount}
+}
+
+// rowError make IndexError for `row`
+func (m *Matrix[T]) rowError(row int) error {
+	return &IndexError{Row: row, Rows: m.rowCount, Columns: m.colCount}
+}
+
+// columnError make IndexError for `col`
+func (m *Matrix[T]) columnError(col int) error {
+	return &IndexError{Column: col, Rows: m.rowCount, Columns: m.colCount}
+}
@@ -0,0 +1,78 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	var m *Matrix[int]
+	_, err := m.Get(0, 0)
+	if !errors.Is(err, ErrNilMatrix) {
+		t.Fatal("check nil object fail")
+	}
+
+	m = NewZeroMatrix[int](3, 4)
+	checks := []error{
+		m.Set(3, 0, 1),
+		m.RemoveRow(5),
+		m.SetBatch(1, makeIter([]struct{ row, column int }{{0, 9}})),
+	}
+	_, err = m.Get(0, -1)
+	checks = append(checks, err)
+	_, err = m.RowData(-1)
+	checks = append(checks, err)
+	_, err = m.ColumnData(4)
+	checks = append(checks, err)
+	_, err = m.AnyOfPoints(makeIter([]struct{ row, column int }{{7, 0}}), func(cell int) bool { return false })
+	checks = append(checks, err)
+
+	for i, err := range checks {
+		if !errors.Is(err, ErrInvalidIndex) {
+			t.Errorf("check %d: act: %v exp: %v", i, err, ErrInvalidIndex)
+		}
+		if err.Error() != InvalidIndexError {
+			t.Errorf("check %d: act: %s exp: %s", i, err.Error(), InvalidIndexError)
+		}
+	}
+
+	_, err = NewMatrix(make([]int, 5), 2, 2)
+	if !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("act: %v exp: %v", err, ErrInvalidSize)
+	}
+}
+
+func TestIndexError(t *testing.T) {
+	m := NewZeroMatrix[int](3, 4)
+
+	_, err := m.Get(5, 2)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("act: %T exp: *IndexError", err)
+	}
+	exp := IndexError{Row: 5, Column: 2, Rows: 3, Columns: 4}
+	if *indexErr != exp {
+		t.Errorf("act: %+v exp: %+v", *indexErr, exp)
+	}
+
+	_, err = m.ColumnData(-2)
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("act: %T exp: *IndexError", err)
+	}
+	exp = IndexError{Column: -2, Rows: 3, Columns: 4}
+	if *indexErr != exp {
+		t.Errorf("act: %+v exp: %+v", *indexErr, exp)
+	}
+}
+
+func TestSizeError(t *testing.T) {
+	_, err := NewMatrix(make([]int, 5), 3, 2)
+
+	var sizeErr *SizeError
+	if !errors.As(err, &sizeErr) {
+		t.Fatalf("act: %T exp: *SizeError", err)
+	}
+	if sizeErr.Expected != 6 || sizeErr.Actual != 5 {
+		t.Errorf("act: %+v exp: {Expected:6 Actual:5}", *sizeErr)
+	}
+}
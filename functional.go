@@ -0,0 +1,163 @@
+package matrix
+
+// Map make new matrix with `f` applied to each cell of `m`
+func Map[T, U any](m *Matrix[T], f func(cell T) U) (*Matrix[U], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	res := NewZeroMatrix[U](m.rowCount, m.colCount)
+	for i, cell := range m.cells {
+		res.cells[i] = f(cell)
+	}
+	return res, nil
+}
+
+// MapIndexed make new matrix with `f` applied to each cell of `m` and its position
+func MapIndexed[T, U any](m *Matrix[T], f func(row, column int, cell T) U) (*Matrix[U], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	res := NewZeroMatrix[U](m.rowCount, m.colCount)
+	for i, cell := range m.cells {
+		res.cells[i] = f(i/m.colCount, i%m.colCount, cell)
+	}
+	return res, nil
+}
+
+// Apply replace each cell with result of `f`
+func (m *Matrix[T]) Apply(f func(cell T) T) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	for i, cell := range m.cells {
+		m.cells[i] = f(cell)
+	}
+	return nil
+}
+
+// Zip make new matrix with `f` applied to each pair of cells of equally shaped `a` and `b`
+func Zip[T, U, V any](a *Matrix[T], b *Matrix[U], f func(x T, y U) V) (*Matrix[V], error) {
+	if a == nil || b == nil {
+		return nil, ErrNilMatrix
+	}
+	if a.rowCount != b.rowCount || a.colCount != b.colCount {
+		return nil, dimensionError(a, b)
+	}
+
+	res := NewZeroMatrix[V](a.rowCount, a.colCount)
+	for i := range a.cells {
+		res.cells[i] = f(a.cells[i], b.cells[i])
+	}
+	return res, nil
+}
+
+// Fold combine all cells row by row with `f` starting from `init`
+func Fold[T, A any](m *Matrix[T], init A, f func(acc A, cell T) A) (A, error) {
+	if m == nil {
+		return init, ErrNilMatrix
+	}
+
+	acc := init
+	for _, cell := range m.cells {
+		acc = f(acc, cell)
+	}
+	return acc, nil
+}
+
+// Reduce combine all cells row by row with `f` using the first cell as initial value.
+// Return ErrInvalidSize for matrix without cells
+func Reduce[T any](m *Matrix[T], f func(acc, cell T) T) (T, error) {
+	var empty T
+	if m == nil {
+		return empty, ErrNilMatrix
+	}
+	if len(m.cells) == 0 {
+		return empty, ErrInvalidSize
+	}
+
+	acc := m.cells[0]
+	for _, cell := range m.cells[1:] {
+		acc = f(acc, cell)
+	}
+	return acc, nil
+}
+
+// FoldRows combine cells of each row with `f` starting from `init`.
+// Result has one value per row
+func FoldRows[T, A any](m *Matrix[T], init A, f func(acc A, cell T) A) ([]A, error) {
+	if m == nil {
+		return []A{}, ErrNilMatrix
+	}
+
+	res := make([]A, m.rowCount)
+	for row := range res {
+		acc := init
+		for _, cell := range m.cells[row*m.colCount : (row+1)*m.colCount] {
+			acc = f(acc, cell)
+		}
+		res[row] = acc
+	}
+	return res, nil
+}
+
+// FoldColumns combine cells of each column with `f` starting from `init`.
+// Result has one value per column
+func FoldColumns[T, A any](m *Matrix[T], init A, f func(acc A, cell T) A) ([]A, error) {
+	if m == nil {
+		return []A{}, ErrNilMatrix
+	}
+
+	res := make([]A, m.colCount)
+	for col := range res {
+		res[col] = init
+	}
+	for i, cell := range m.cells {
+		col := i % m.colCount
+		res[col] = f(res[col], cell)
+	}
+	return res, nil
+}
+
+// ReduceRows combine cells of each row with `f` using the first cell of row as initial value.
+// Return ErrInvalidSize for matrix without columns
+func ReduceRows[T any](m *Matrix[T], f func(acc, cell T) T) ([]T, error) {
+	if m == nil {
+		return []T{}, ErrNilMatrix
+	}
+	if m.colCount == 0 && m.rowCount > 0 {
+		return []T{}, ErrInvalidSize
+	}
+
+	res := make([]T, m.rowCount)
+	for row := range res {
+		start := row * m.colCount
+		acc := m.cells[start]
+		for _, cell := range m.cells[start+1 : start+m.colCount] {
+			acc = f(acc, cell)
+		}
+		res[row] = acc
+	}
+	return res, nil
+}
+
+// ReduceColumns combine cells of each column with `f` using the first cell of column
+// as initial value. Return ErrInvalidSize for matrix without rows
+func ReduceColumns[T any](m *Matrix[T], f func(acc, cell T) T) ([]T, error) {
+	if m == nil {
+		return []T{}, ErrNilMatrix
+	}
+	if m.rowCount == 0 && m.colCount > 0 {
+		return []T{}, ErrInvalidSize
+	}
+
+	res := make([]T, m.colCount)
+	copy(res, m.cells)
+	for i := m.colCount; i < len(m.cells); i++ {
+		col := i % m.colCount
+		res[col] = f(res[col], m.cells[i])
+	}
+	return res, nil
+}
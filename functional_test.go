@@ -0,0 +1,170 @@
+package matrix
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	var m *Matrix[int]
+	_, err := Map(m, strconv.Itoa)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{1, 2, 3, 4, 5, 6}, 2, 3)
+	act, err := Map(m, strconv.Itoa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(act.cells, []string{"1", "2", "3", "4", "5", "6"}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if act.rowCount != 2 || act.colCount != 3 {
+		t.Error("check row and colun size")
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	var m *Matrix[int]
+	_, err := MapIndexed(m, func(row, column, cell int) Point { return Point{} })
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m = NewZeroMatrix[int](2, 2)
+	act, err := MapIndexed(m, func(row, column, cell int) Point { return Point{row, column} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(act.cells, []Point{{0, 0}, {0, 1}, {1, 0}, {1, 1}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestApply(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.Apply(func(cell int) int { return cell }); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{1, 2, 3, 4}, 2, 2)
+	if err := m.Apply(func(cell int) int { return cell * cell }); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, []int{1, 4, 9, 16}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestZip(t *testing.T) {
+	a, _ := NewMatrix([]string{"a", "b", "c", "d"}, 2, 2)
+	b, _ := NewMatrix([]int{1, 2, 3, 4}, 2, 2)
+
+	_, err := Zip(a, (*Matrix[int])(nil), func(x string, y int) string { return x })
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	_, err = Zip(a, NewZeroMatrix[int](1, 4), func(x string, y int) string { return x })
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("check dimension mismatch fail")
+	}
+
+	act, err := Zip(a, b, func(x string, y int) string { return x + strconv.Itoa(y) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(act.cells, []string{"a1", "b2", "c3", "d4"}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestFoldReduce(t *testing.T) {
+	var m *Matrix[int]
+	if _, err := Fold(m, 0, func(acc, cell int) int { return acc }); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if _, err := Reduce(m, func(acc, cell int) int { return acc }); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	joined, err := Fold(m, "", func(acc string, cell int) string { return acc + strconv.Itoa(cell) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joined != "123456" {
+		t.Errorf("act: %s exp: 123456", joined)
+	}
+
+	sum, err := Reduce(m, func(acc, cell int) int { return acc + cell })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 21 {
+		t.Errorf("act: %d exp: 21", sum)
+	}
+
+	_, err = Reduce(NewZeroMatrix[int](0, 3), func(acc, cell int) int { return acc })
+	if !errors.Is(err, ErrInvalidSize) {
+		t.Error("check invalid size fail")
+	}
+}
+
+func TestFoldRowsColumns(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+	sum := func(acc, cell int) int { return acc + cell }
+
+	rows, err := FoldRows(m, 10, sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(rows, []int{16, 25}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	cols, err := FoldColumns(m, 10, sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(cols, []int{15, 17, 19}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	maxOf := func(acc, cell int) int { return max(acc, cell) }
+	rows, err = ReduceRows(m, maxOf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(rows, []int{3, 6}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	cols, err = ReduceColumns(m, func(acc, cell int) int { return acc - cell })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(cols, []int{-3, -3, -3}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	var nilMatrix *Matrix[int]
+	if _, err = FoldRows(nilMatrix, 0, sum); err.Error() != NilMatrixObject {
+		t.Error("check nil object fail")
+	}
+	if _, err = FoldColumns(nilMatrix, 0, sum); err.Error() != NilMatrixObject {
+		t.Error("check nil object fail")
+	}
+	if _, err = ReduceRows(NewZeroMatrix[int](2, 0), sum); !errors.Is(err, ErrInvalidSize) {
+		t.Error("check invalid size fail")
+	}
+	if _, err = ReduceColumns(NewZeroMatrix[int](0, 2), sum); !errors.Is(err, ErrInvalidSize) {
+		t.Error("check invalid size fail")
+	}
+}
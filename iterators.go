@@ -0,0 +1,128 @@
+package matrix
+
+import (
+	"iter"
+)
+
+// All get sequence of all cells with their positions row by row
+func (m *Matrix[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if m == nil {
+			return
+		}
+		for i, cell := range m.cells {
+			if !yield(Point{i / m.colCount, i % m.colCount}, cell) {
+				return
+			}
+		}
+	}
+}
+
+// Rows get sequence of rows with their indices. Row slices share memory
+// with matrix, so they are valid only until matrix reallocation
+func (m *Matrix[T]) Rows() iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		if m == nil {
+			return
+		}
+		for row := 0; row < m.rowCount; row++ {
+			start := row * m.colCount
+			if !yield(row, m.cells[start:start+m.colCount:start+m.colCount]) {
+				return
+			}
+		}
+	}
+}
+
+// Column get sequence of cells of column `col` from top to bottom.
+// Sequence is empty if `col` is out of matrix bounds
+func (m *Matrix[T]) Column(col int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m == nil || col < 0 || col >= m.colCount {
+			return
+		}
+		for i := col; i < len(m.cells); i += m.colCount {
+			if !yield(m.cells[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Columns get sequence of columns with their indices
+func (m *Matrix[T]) Columns() iter.Seq2[int, iter.Seq[T]] {
+	return func(yield func(int, iter.Seq[T]) bool) {
+		if m == nil {
+			return
+		}
+		for col := 0; col < m.colCount; col++ {
+			if !yield(col, m.Column(col)) {
+				return
+			}
+		}
+	}
+}
+
+// Diagonal get sequence of cells [i, i] from top left corner
+func (m *Matrix[T]) Diagonal() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if m == nil {
+			return
+		}
+		for i := 0; i < m.rowCount && i < m.colCount; i++ {
+			if !yield(Point{i, i}, m.cells[calcIndex(i, i, m.colCount)]) {
+				return
+			}
+		}
+	}
+}
+
+// AntiDiagonal get sequence of cells [i, columns-1-i] from top right corner
+func (m *Matrix[T]) AntiDiagonal() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if m == nil {
+			return
+		}
+		for i := 0; i < m.rowCount && i < m.colCount; i++ {
+			col := m.colCount - 1 - i
+			if !yield(Point{i, col}, m.cells[calcIndex(i, col, m.colCount)]) {
+				return
+			}
+		}
+	}
+}
+
+// Region get sequence of cells inside `r` row by row.
+// Part of `r` outside matrix bounds is skipped
+func (m *Matrix[T]) Region(r Rect) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if m == nil {
+			return
+		}
+		rowFrom, rowTo := max(r.Row, 0), min(r.Row+r.Rows, m.rowCount)
+		colFrom, colTo := max(r.Column, 0), min(r.Column+r.Columns, m.colCount)
+		for row := rowFrom; row < rowTo; row++ {
+			for col := colFrom; col < colTo; col++ {
+				if !yield(Point{row, col}, m.cells[calcIndex(row, col, m.colCount)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All get sequence of all view cells with their positions inside view row by row
+func (v *View[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		if v == nil {
+			return
+		}
+		for row := 0; row < v.rowCount; row++ {
+			for col := 0; col < v.colCount; col++ {
+				if !yield(Point{row, col}, v.cells[v.at(row, col)]) {
+					return
+				}
+			}
+		}
+	}
+}
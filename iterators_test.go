@@ -0,0 +1,165 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	var m *Matrix[int]
+	for range m.All() {
+		t.Fatal("nil matrix yields cells")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	var points []Point
+	var cells []int
+	for p, cell := range m.All() {
+		points = append(points, p)
+		cells = append(cells, cell)
+	}
+	if cmpRes := compareSlices(cells, m.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(points, []Point{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	for p := range m.All() {
+		if p.Row == 1 {
+			break
+		}
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		sum := 0
+		for _, cell := range m.All() {
+			sum += cell
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("act: %v allocs exp: 0", allocs)
+	}
+}
+
+func TestRows(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	var indices []int
+	for row, data := range m.Rows() {
+		indices = append(indices, row)
+		exp, _ := m.RowData(row)
+		if cmpRes := compareSlices(data, exp); cmpRes != nil {
+			t.Error(cmpRes)
+		}
+		data[0] = -1
+	}
+	if cmpRes := compareSlices(indices, []int{0, 1}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(m.cells, []int{-1, 2, 3, -1, 5, 6}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	for col, data := range m.Columns() {
+		var act []int
+		for cell := range data {
+			act = append(act, cell)
+		}
+		exp, _ := m.ColumnData(col)
+		if cmpRes := compareSlices(act, exp); cmpRes != nil {
+			t.Error(cmpRes)
+		}
+	}
+
+	for range m.Column(3) {
+		t.Error("invalid column yields cells")
+	}
+}
+
+func TestDiagonals(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12}, 3, 4)
+
+	var points []Point
+	var cells []int
+	for p, cell := range m.Diagonal() {
+		points = append(points, p)
+		cells = append(cells, cell)
+	}
+	if cmpRes := compareSlices(cells, []int{1, 6, 11}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(points, []Point{{0, 0}, {1, 1}, {2, 2}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	points, cells = nil, nil
+	for p, cell := range m.AntiDiagonal() {
+		points = append(points, p)
+		cells = append(cells, cell)
+	}
+	if cmpRes := compareSlices(cells, []int{4, 7, 10}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(points, []Point{{0, 3}, {1, 2}, {2, 1}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestRegion(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+
+	test := func(r Rect, exp []int) {
+		var act []int
+		for p, cell := range m.Region(r) {
+			if v, _ := m.Get(p.Row, p.Column); v != cell {
+				t.Errorf("act: %d exp: %d at %v", cell, v, p)
+			}
+			act = append(act, cell)
+		}
+		if cmpRes := compareSlices(act, exp); cmpRes != nil {
+			t.Errorf("%v: %v", r, cmpRes)
+		}
+	}
+
+	test(Rect{1, 1, 2, 2}, []int{5, 6, 8, 9})
+	test(Rect{-1, 2, 2, 5}, []int{3})
+	test(Rect{3, 0, 1, 1}, nil)
+}
+
+func TestViewAll(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	v, _ := m.View(1, 1, 2, 2)
+
+	var points []Point
+	var cells []int
+	for p, cell := range v.All() {
+		points = append(points, p)
+		cells = append(cells, cell)
+	}
+	if cmpRes := compareSlices(cells, []int{5, 6, 8, 9}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(points, []Point{{0, 0}, {0, 1}, {1, 0}, {1, 1}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,83 @@
+package matrix
+
+import (
+	"math"
+)
+
+// Gaussian make normalized (2*radius+1) x (2*radius+1) Gaussian blur kernel
+func Gaussian(radius int, sigma float64) (*Matrix[float64], error) {
+	v, err := gaussianVector(radius, sigma)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(v)
+	m := NewZeroMatrix[float64](n, n)
+	for a := range v {
+		for b := range v {
+			m.cells[a*n+b] = v[a] * v[b]
+		}
+	}
+	return m, nil
+}
+
+// gaussianVector make normalized one-dimensional Gaussian kernel
+func gaussianVector(radius int, sigma float64) ([]float64, error) {
+	if radius < 0 || !(sigma > 0) {
+		return nil, ErrInvalidArgument
+	}
+
+	v := make([]float64, 2*radius+1)
+	sum := 0.0
+	for i := range v {
+		x := float64(i - radius)
+		v[i] = math.Exp(-x * x / (2 * sigma * sigma))
+		sum += v[i]
+	}
+	for i := range v {
+		v[i] /= sum
+	}
+	return v, nil
+}
+
+// Box make size x size mean filter kernel
+func Box(size int) (*Matrix[float64], error) {
+	if size <= 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	m := NewZeroMatrix[float64](size, size)
+	for i := range m.cells {
+		m.cells[i] = 1 / float64(size*size)
+	}
+	return m, nil
+}
+
+// SobelX make Sobel kernel for horizontal gradient. Kernel is oriented for
+// Correlate: gradient is positive where values grow to the right
+func SobelX() *Matrix[float64] {
+	m, _ := NewMatrix([]float64{
+		-1, 0, 1,
+		-2, 0, 2,
+		-1, 0, 1}, 3, 3)
+	return m
+}
+
+// SobelY make Sobel kernel for vertical gradient. Kernel is oriented for
+// Correlate: gradient is positive where values grow downwards
+func SobelY() *Matrix[float64] {
+	m, _ := NewMatrix([]float64{
+		-1, -2, -1,
+		0, 0, 0,
+		1, 2, 1}, 3, 3)
+	return m
+}
+
+// Laplacian make 3x3 Laplacian kernel with 4-connected neighbours
+func Laplacian() *Matrix[float64] {
+	m, _ := NewMatrix([]float64{
+		0, 1, 0,
+		1, -4, 1,
+		0, 1, 0}, 3, 3)
+	return m
+}
@@ -0,0 +1,80 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGaussian(t *testing.T) {
+	if _, err := Gaussian(-1, 1); err.Error() != InvalidArgument {
+		t.Error("check negative radius fail")
+	}
+	if _, err := Gaussian(1, 0); err.Error() != InvalidArgument {
+		t.Error("check zero sigma fail")
+	}
+
+	g, err := Gaussian(2, 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.rowCount != 5 || g.colCount != 5 {
+		t.Errorf("act: %dx%d exp: 5x5", g.rowCount, g.colCount)
+	}
+
+	sum := 0.0
+	for _, cell := range g.cells {
+		sum += cell
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("act: sum %g exp: 1", sum)
+	}
+	if sym, _ := Symmetries(g); len(sym) != 8 {
+		t.Errorf("act: %d symmetries exp: 8", len(sym))
+	}
+	if g.cells[12] <= g.cells[11] || g.cells[11] <= g.cells[10] {
+		t.Error("kernel must decrease from center")
+	}
+}
+
+func TestBox(t *testing.T) {
+	if _, err := Box(0); err.Error() != InvalidArgument {
+		t.Error("check invalid size fail")
+	}
+
+	b, _ := Box(3)
+	m := convSample()
+	act, err := Correlate(m, b, ConvOptions{Padding: PaddingValid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(act.cells, []float64{5}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestEdgeKernels(t *testing.T) {
+	m := convSample()
+	opts := ConvOptions{Padding: PaddingValid}
+
+	act, _ := Correlate(m, SobelX(), opts)
+	if cmpRes := compareFloats(act.cells, []float64{8}, 1e-12); cmpRes != nil {
+		t.Error("SobelX:", cmpRes)
+	}
+	act, _ = Correlate(m, SobelY(), opts)
+	if cmpRes := compareFloats(act.cells, []float64{24}, 1e-12); cmpRes != nil {
+		t.Error("SobelY:", cmpRes)
+	}
+	act, _ = Correlate(m, Laplacian(), opts)
+	if cmpRes := compareFloats(act.cells, []float64{0}, 1e-12); cmpRes != nil {
+		t.Error("Laplacian:", cmpRes)
+	}
+
+	act, _ = Correlate(m, Laplacian(), ConvOptions{Edge: EdgeClip})
+	exp := []float64{
+		2, 1, -4,
+		-3, 0, -7,
+		-16, -11, -22}
+	if cmpRes := compareFloats(act.cells, exp, 1e-12); cmpRes != nil {
+		t.Error("Laplacian same:", cmpRes)
+	}
+}
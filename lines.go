@@ -0,0 +1,37 @@
+package matrix
+
+import (
+	"slices"
+)
+
+// ClearRows remove every row satisfying `pred` in a single pass, shift remaining
+// rows down and fill freed top rows with `fill`. Matrix size is not changed.
+// Return indices of cleared rows (before shifting) in ascending order.
+// `pred` gets row cells sharing memory with matrix and must not modify them
+func (m *Matrix[T]) ClearRows(pred func(row []T) bool, fill T) (cleared []int, err error) {
+	if m == nil {
+		return []int{}, ErrNilMatrix
+	}
+
+	cleared = make([]int, 0)
+	to := m.rowCount - 1
+	for row := m.rowCount - 1; row >= 0; row-- {
+		cells := m.cells[row*m.colCount : (row+1)*m.colCount]
+		if pred(cells) {
+			cleared = append(cleared, row)
+			continue
+		}
+		if to != row {
+			copy(m.cells[to*m.colCount:(to+1)*m.colCount], cells)
+		}
+		to--
+	}
+
+	for i := 0; i < (to+1)*m.colCount; i++ {
+		m.cells[i] = fill
+	}
+
+	slices.Reverse(cleared)
+
+	return cleared, nil
+}
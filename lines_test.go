@@ -0,0 +1,74 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestClearRows(t *testing.T) {
+	full := func(row []int) bool {
+		for _, cell := range row {
+			if cell == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	var m *Matrix[int]
+	if _, err := m.ClearRows(full, 0); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		0, 1, 0,
+		1, 1, 1,
+		2, 0, 2,
+		3, 3, 3,
+		4, 4, 4,
+		0, 5, 5}, 6, 3)
+
+	cleared, err := m.ClearRows(full, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(cleared, []int{1, 3, 4}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	exp := []int{
+		-1, -1, -1,
+		-1, -1, -1,
+		-1, -1, -1,
+		0, 1, 0,
+		2, 0, 2,
+		0, 5, 5}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	cleared, err = m.ClearRows(full, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(cleared, []int{0, 1, 2}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	exp = []int{
+		0, 0, 0,
+		0, 0, 0,
+		0, 0, 0,
+		0, 1, 0,
+		2, 0, 2,
+		0, 5, 5}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	cleared, _ = m.ClearRows(full, 9)
+	if len(cleared) != 0 {
+		t.Errorf("act: %v exp: []", cleared)
+	}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
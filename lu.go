@@ -0,0 +1,202 @@
+package matrix
+
+// LU is a factorization PA = LU with partial pivoting of square matrix A,
+// where L is unit lower triangular and U is upper triangular
+type LU[F Float] struct {
+	// lu store L below diagonal (without unit diagonal) and U on and above it
+	lu *Matrix[F]
+	// pivot[i] is the row of A placed to row i of PA
+	pivot []int
+	// sign is the permutation parity: 1 or -1
+	sign F
+	// singular is the first column without usable pivot or -1
+	singular int
+}
+
+// checkSquare check `m` exists and is square
+func checkSquare[T any](m *Matrix[T]) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if m.rowCount != m.colCount {
+		return ErrNotSquare
+	}
+	return nil
+}
+
+// NewLU factorize square matrix `m`. Singular matrix is factorized too,
+// but solving with its factorization returns SingularError
+func NewLU[F Float](m *Matrix[F]) (*LU[F], error) {
+	if err := checkSquare(m); err != nil {
+		return nil, err
+	}
+
+	n := m.rowCount
+	lu := m.clone()
+	a := lu.cells
+	f := &LU[F]{lu: lu, pivot: make([]int, n), sign: 1, singular: -1}
+	for i := range f.pivot {
+		f.pivot[i] = i
+	}
+
+	tol := F(n) * epsilon[F]() * maxAbs(a)
+
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if abs(a[i*n+k]) > abs(a[p*n+k]) {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a[p*n+j], a[k*n+j] = a[k*n+j], a[p*n+j]
+			}
+			f.pivot[p], f.pivot[k] = f.pivot[k], f.pivot[p]
+			f.sign = -f.sign
+		}
+
+		pivot := a[k*n+k]
+		if abs(pivot) <= tol {
+			if f.singular < 0 {
+				f.singular = k
+			}
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			a[i*n+k] /= pivot
+			l := a[i*n+k]
+			if l == 0 {
+				continue
+			}
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= l * a[k*n+j]
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// L get unit lower triangular factor
+func (f *LU[F]) L() *Matrix[F] {
+	n := f.lu.rowCount
+	l := NewZeroMatrix[F](n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l.cells[i*n+j] = f.lu.cells[i*n+j]
+		}
+		l.cells[i*n+i] = 1
+	}
+	return l
+}
+
+// U get upper triangular factor
+func (f *LU[F]) U() *Matrix[F] {
+	n := f.lu.rowCount
+	u := NewZeroMatrix[F](n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u.cells[i*n+j] = f.lu.cells[i*n+j]
+		}
+	}
+	return u
+}
+
+// Pivot get row permutation: row `i` of PA is row `Pivot()[i]` of A
+func (f *LU[F]) Pivot() []int {
+	res := make([]int, len(f.pivot))
+	copy(res, f.pivot)
+	return res
+}
+
+// P get permutation matrix
+func (f *LU[F]) P() *Matrix[F] {
+	n := len(f.pivot)
+	p := NewZeroMatrix[F](n, n)
+	for i, row := range f.pivot {
+		p.cells[i*n+row] = 1
+	}
+	return p
+}
+
+// IsSingular report if factorized matrix is singular
+func (f *LU[F]) IsSingular() bool {
+	return f.singular >= 0
+}
+
+// Det get determinant of factorized matrix
+func (f *LU[F]) Det() F {
+	if f.IsSingular() {
+		return 0
+	}
+	n := f.lu.rowCount
+	det := f.sign
+	for i := 0; i < n; i++ {
+		det *= f.lu.cells[i*n+i]
+	}
+	return det
+}
+
+// Solve get X for AX = `b`
+func (f *LU[F]) Solve(b *Matrix[F]) (*Matrix[F], error) {
+	if b == nil {
+		return nil, ErrNilMatrix
+	}
+	if b.rowCount != f.lu.rowCount {
+		return nil, dimensionError(f.lu, b)
+	}
+	if f.IsSingular() {
+		return nil, &SingularError{Column: f.singular}
+	}
+
+	n, cols := f.lu.rowCount, b.colCount
+	a := f.lu.cells
+	x := NewZeroMatrix[F](n, cols)
+	for i, row := range f.pivot {
+		copy(x.cells[i*cols:(i+1)*cols], b.cells[row*cols:(row+1)*cols])
+	}
+
+	for c := 0; c < cols; c++ {
+		for i := 1; i < n; i++ {
+			sum := x.cells[i*cols+c]
+			for k := 0; k < i; k++ {
+				sum -= a[i*n+k] * x.cells[k*cols+c]
+			}
+			x.cells[i*cols+c] = sum
+		}
+		for i := n - 1; i >= 0; i-- {
+			sum := x.cells[i*cols+c]
+			for k := i + 1; k < n; k++ {
+				sum -= a[i*n+k] * x.cells[k*cols+c]
+			}
+			x.cells[i*cols+c] = sum / a[i*n+i]
+		}
+	}
+
+	return x, nil
+}
+
+// Inverse get inverse of factorized matrix
+func (f *LU[F]) Inverse() (*Matrix[F], error) {
+	return f.Solve(Identity[F](f.lu.rowCount))
+}
+
+// Det get determinant of square matrix `m`
+func Det[F Float](m *Matrix[F]) (F, error) {
+	f, err := NewLU(m)
+	if err != nil {
+		return 0, err
+	}
+	return f.Det(), nil
+}
+
+// Inverse get inverse of square matrix `m`
+func Inverse[F Float](m *Matrix[F]) (*Matrix[F], error) {
+	f, err := NewLU(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.Inverse()
+}
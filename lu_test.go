@@ -0,0 +1,171 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func compareFloats[F Float](act, exp []F, tol float64) error {
+	if len(act) != len(exp) {
+		return errors.New("different len")
+	}
+
+	for i := 0; i < len(act); i++ {
+		if math.Abs(float64(act[i]-exp[i])) > tol {
+			return fmt.Errorf("act: %v exp: %v at index: %d", act[i], exp[i], i)
+		}
+	}
+
+	return nil
+}
+
+func TestLU(t *testing.T) {
+	_, err := NewLU[float64](nil)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	_, err = NewLU(NewZeroMatrix[float64](2, 3))
+	if !errors.Is(err, ErrNotSquare) {
+		t.Fatal("check not square fail")
+	}
+
+	m, _ := NewMatrix([]float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 10}, 3, 3)
+	f, err := NewLU(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cmpRes := compareSlices(f.Pivot(), []int{2, 0, 1}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	pa, _ := Mul(f.P(), m)
+	lu, _ := Mul(f.L(), f.U())
+	if cmpRes := compareFloats(lu.cells, pa.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if v, _ := f.L().Get(i, j); v != 0 {
+				t.Errorf("L is not lower triangular at [%d,%d]", i, j)
+			}
+			if v, _ := f.U().Get(j, i); v != 0 {
+				t.Errorf("U is not upper triangular at [%d,%d]", j, i)
+			}
+		}
+	}
+}
+
+func TestDet(t *testing.T) {
+	_, err := Det(NewZeroMatrix[float64](3, 1))
+	if err.Error() != NotSquareMatrix {
+		t.Fatal("check not square fail")
+	}
+
+	test := func(d []float64, n int, exp float64) {
+		t.Run(fmt.Sprint(d), func(t *testing.T) {
+			m, _ := NewMatrix(d, n, n)
+			act, err := Det(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(act-exp) > 1e-9 {
+				t.Errorf("act: %v exp: %v", act, exp)
+			}
+		})
+	}
+
+	test([]float64{5}, 1, 5)
+	test([]float64{1, 2, 3, 4}, 2, -2)
+	test([]float64{0, 1, 1, 0}, 2, -1)
+	test([]float64{1, 2, 3, 4, 5, 6, 7, 8, 10}, 3, -3)
+	test([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, 3, 0)
+
+	m32, _ := NewMatrix([]float32{2, 0, 0, 0, 3, 0, 0, 0, 4}, 3, 3)
+	det32, err := Det(m32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if det32 != 24 {
+		t.Errorf("act: %v exp: 24", det32)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		4, 7,
+		2, 6}, 2, 2)
+	inv, err := Inverse(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []float64{0.6, -0.7, -0.2, 0.4}
+	if cmpRes := compareFloats(inv.cells, exp, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	m32, _ := NewMatrix([]float32{
+		2, -1, 0,
+		-1, 2, -1,
+		0, -1, 2}, 3, 3)
+	inv32, err := Inverse(m32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := Mul(m32, inv32)
+	if cmpRes := compareFloats(id.cells, Identity[float32](3).cells, 1e-5); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	singular, _ := NewMatrix([]float64{
+		1, 2, 3,
+		2, 4, 6,
+		1, 1, 1}, 3, 3)
+	_, err = Inverse(singular)
+	var singularErr *SingularError
+	if !errors.As(err, &singularErr) {
+		t.Fatalf("act: %v exp: *SingularError", err)
+	}
+	if !errors.Is(err, ErrSingularMatrix) {
+		t.Error("check singular matrix fail")
+	}
+}
+
+func TestLUSolve(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		2, 1, -1,
+		-3, -1, 2,
+		-2, 1, 2}, 3, 3)
+	f, err := NewLU(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.Solve(NewZeroMatrix[float64](2, 1))
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatal("check dimension mismatch fail")
+	}
+
+	b, _ := NewMatrix([]float64{
+		8, 1,
+		-11, 0,
+		-3, 0}, 3, 2)
+	x, err := f.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ax, _ := Mul(m, x)
+	if cmpRes := compareFloats(ax.cells, b.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareFloats([]float64{x.cells[0], x.cells[2], x.cells[4]}, []float64{2, 3, -1}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
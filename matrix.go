@@ -1,16 +1,9 @@
 package matrix
 
-import (
-	"errors"
-)
-
-const (
-	InvalidIndexError = "InvalidIndexError"
-	NilMatrixObject   = "NilMatrixObject"
-	InvalidMatrixSize = "InvalidMatrixSize"
-)
-
-// PairIterator interface for iteraing on any collection with 2 values
+// PairIterator interface for iteraing on any collection with 2 values.
+// Every consumer in this package calls Begin before reading the iterator,
+// so the whole collection is read regardless of iterator position.
+// Wrap iterator with FromCurrent to read it from the current position
 type PairIterator interface {
 	// Begin set iterator to begin
 	Begin()
@@ -37,11 +30,26 @@ func NewZeroMatrix[T any](rows, columns int) *Matrix[T] {
 // New Matrix create matrix from slice of data with spicified size
 func NewMatrix[T any](data []T, rows, columns int) (*Matrix[T], error) {
 	if len(data) != rows*columns {
-		return nil, errors.New(InvalidMatrixSize)
+		return nil, &SizeError{Expected: rows * columns, Actual: len(data)}
 	}
 	return &Matrix[T]{data, rows, columns}, nil
 }
 
+// Size get rows and columns count of matrix
+func (m *Matrix[T]) Size() (int, int) {
+	if m == nil {
+		return 0, 0
+	}
+	return m.rowCount, m.colCount
+}
+
+// clone make deep copy of matrix
+func (m *Matrix[T]) clone() *Matrix[T] {
+	cells := make([]T, len(m.cells))
+	copy(cells, m.cells)
+	return &Matrix[T]{cells, m.rowCount, m.colCount}
+}
+
 func calcIndex(row, col, maxCol int) int {
 	return maxCol*row + col
 }
@@ -60,17 +68,17 @@ func NewMatrixFromPoints[T any](points PairIterator, value T) *Matrix[T] {
 		}
 	}
 
-	points.Begin()
-	for points.Next() {
-		calcMax(points.First(), points.Second())
+	pts := make([]Point, 0)
+	for p := range PairSeq(points) {
+		calcMax(p.Row, p.Column)
+		pts = append(pts, p)
 	}
 	maxCol++
 	maxRow++
 
 	d := make([]T, maxCol*maxRow)
-	points.Begin()
-	for points.Next() {
-		d[calcIndex(points.First(), points.Second(), maxCol)] = value
+	for _, p := range pts {
+		d[calcIndex(p.Row, p.Column, maxCol)] = value
 	}
 
 	m, _ := NewMatrix(d, maxRow, maxCol)
@@ -81,7 +89,7 @@ func NewMatrixFromPoints[T any](points PairIterator, value T) *Matrix[T] {
 func (m *Matrix[T]) Filtered(f func(cell T) bool) ([]struct{ Row, Column int }, error) {
 
 	if m == nil {
-		return []struct{ Row, Column int }{}, errors.New(NilMatrixObject)
+		return []struct{ Row, Column int }{}, ErrNilMatrix
 	}
 
 	d := make([]struct{ Row, Column int }, 0)
@@ -96,13 +104,22 @@ func (m *Matrix[T]) Filtered(f func(cell T) bool) ([]struct{ Row, Column int },
 	return d, nil
 }
 
+// FilteredPoints get list of points which satisfy `f`
+func (m *Matrix[T]) FilteredPoints(f func(cell T) bool) (*PointList, error) {
+	d, err := m.Filtered(f)
+	if err != nil {
+		return NewPointList(), err
+	}
+	return PointsFromFiltered(d), nil
+}
+
 // index convert square coords into slice index
 func (m *Matrix[T]) index(row, col int) (int, error) {
 	if m == nil {
-		return 0, errors.New(NilMatrixObject)
+		return 0, ErrNilMatrix
 	}
 	if row < 0 || col < 0 || row >= m.rowCount || col >= m.colCount {
-		return 0, errors.New(InvalidIndexError)
+		return 0, m.cellError(row, col)
 	}
 
 	return calcIndex(row, col, m.colCount), nil
@@ -111,10 +128,10 @@ func (m *Matrix[T]) index(row, col int) (int, error) {
 // pos convert slice index int square coords
 func (m *Matrix[T]) pos(index int) (int, int, error) {
 	if m == nil {
-		return 0, 0, errors.New(NilMatrixObject)
+		return 0, 0, ErrNilMatrix
 	}
 	if index < 0 || index >= len(m.cells) {
-		return 0, 0, errors.New(InvalidIndexError)
+		return 0, 0, ErrInvalidIndex
 	}
 	return index / m.colCount, index - (index/m.colCount)*m.colCount, nil
 }
@@ -122,10 +139,10 @@ func (m *Matrix[T]) pos(index int) (int, int, error) {
 // RowData get slice of values stored in spicified row
 func (m *Matrix[T]) RowData(row int) ([]T, error) {
 	if m == nil {
-		return []T{}, errors.New(NilMatrixObject)
+		return []T{}, ErrNilMatrix
 	}
 	if row < 0 || row >= m.rowCount {
-		return []T{}, errors.New(InvalidIndexError)
+		return []T{}, m.rowError(row)
 	}
 
 	res := make([]T, 0, m.colCount)
@@ -142,10 +159,10 @@ func (m *Matrix[T]) RowData(row int) ([]T, error) {
 // ColumnData get slice of values stored in spicified column
 func (m *Matrix[T]) ColumnData(col int) ([]T, error) {
 	if m == nil {
-		return []T{}, errors.New(NilMatrixObject)
+		return []T{}, ErrNilMatrix
 	}
 	if col < 0 || col >= m.colCount {
-		return []T{}, errors.New(InvalidIndexError)
+		return []T{}, m.columnError(col)
 	}
 
 	res := make([]T, 0, m.rowCount)
@@ -160,11 +177,11 @@ func (m *Matrix[T]) ColumnData(col int) ([]T, error) {
 // AnyOfPoints check if for any of `points` success functor `f`
 func (m *Matrix[T]) AnyOfPoints(points PairIterator, f func(cell T) bool) (bool, error) {
 	if m == nil {
-		return false, errors.New(NilMatrixObject)
+		return false, ErrNilMatrix
 	}
 
-	for points.Next() {
-		i, err := m.index(points.First(), points.Second())
+	for p := range PairSeq(points) {
+		i, err := m.index(p.Row, p.Column)
 		if err != nil {
 			return false, err
 		}
@@ -179,10 +196,10 @@ func (m *Matrix[T]) AnyOfPoints(points PairIterator, f func(cell T) bool) (bool,
 // AllOfRow check `f` for each value on `row`
 func (m *Matrix[T]) AllOfRow(row int, f func(cell T) bool) (bool, error) {
 	if m == nil {
-		return false, errors.New(NilMatrixObject)
+		return false, ErrNilMatrix
 	}
 	if row < 0 || row >= m.rowCount {
-		return false, errors.New(InvalidIndexError)
+		return false, m.rowError(row)
 	}
 
 	r, _ := m.RowData(row)
@@ -198,10 +215,10 @@ func (m *Matrix[T]) AllOfRow(row int, f func(cell T) bool) (bool, error) {
 // AllOfColumn check `f` for each value on `col`
 func (m *Matrix[T]) AllOfColumn(col int, f func(cell T) bool) (bool, error) {
 	if m == nil {
-		return false, errors.New(NilMatrixObject)
+		return false, ErrNilMatrix
 	}
 	if col < 0 || col >= m.colCount {
-		return false, errors.New(InvalidIndexError)
+		return false, m.columnError(col)
 	}
 
 	r, _ := m.ColumnData(col)
@@ -217,19 +234,20 @@ func (m *Matrix[T]) AllOfColumn(col int, f func(cell T) bool) (bool, error) {
 // ShiftRowsDown shift all rows down to 1 row. First row make default values row.
 func (m *Matrix[T]) ShiftRowsDown() error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 	return m.RemoveRow(m.rowCount - 1)
 }
 
-// RemoveRow remove `r` row and shift previous rows down
+// RemoveRow remove `r` row and shift previous rows down.
+// Matrix size is not changed, use DeleteRow to drop the row completely
 func (m *Matrix[T]) RemoveRow(r int) error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
 	if r < 0 || r >= m.rowCount {
-		return errors.New(InvalidIndexError)
+		return m.rowError(r)
 	}
 
 	for row := r; row > 0; row-- {
@@ -252,7 +270,7 @@ func (m *Matrix[T]) RemoveRow(r int) error {
 // Set value `value` to cell [row, column]
 func (m *Matrix[T]) Set(row, column int, value T) error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
 	i, err := m.index(row, column)
@@ -269,7 +287,7 @@ func (m *Matrix[T]) Set(row, column int, value T) error {
 func (m *Matrix[T]) Get(row, column int) (T, error) {
 	var empty T
 	if m == nil {
-		return empty, errors.New(NilMatrixObject)
+		return empty, ErrNilMatrix
 	}
 
 	i, err := m.index(row, column)
@@ -280,27 +298,44 @@ func (m *Matrix[T]) Get(row, column int) (T, error) {
 	return m.cells[i], nil
 }
 
-// SetBatch set `value` to each point [row, column] from slice `points`
+// SetBatch set `value` to each point [row, column] from slice `points`.
+// All points are validated first, so on error no cell is modified
 func (m *Matrix[T]) SetBatch(value T, points PairIterator) error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
-	for points.Next() {
-		i, err := m.index(points.First(), points.Second())
+	indices := make([]int, 0)
+	for p := range PairSeq(points) {
+		i, err := m.index(p.Row, p.Column)
 		if err != nil {
 			return err
 		}
+		indices = append(indices, i)
+	}
+
+	for _, i := range indices {
 		m.cells[i] = value
 	}
 
 	return nil
 }
 
-// Transpose transpose matrix
+// Transpose transpose matrix. Square matrix is transposed in place
 func (m *Matrix[T]) Transpose() error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
+	}
+
+	if m.rowCount == m.colCount {
+		for row := 0; row < m.rowCount; row++ {
+			for col := row + 1; col < m.colCount; col++ {
+				a, _ := m.index(row, col)
+				b, _ := m.index(col, row)
+				m.cells[a], m.cells[b] = m.cells[b], m.cells[a]
+			}
+		}
+		return nil
 	}
 
 	newCells := make([]T, 0, len(m.cells))
@@ -320,7 +355,7 @@ func (m *Matrix[T]) Transpose() error {
 // MirrorRows reverse row order
 func (m *Matrix[T]) MirrorRows() error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
 	for bRow, eRow := 0, m.rowCount-1; bRow < eRow; bRow, eRow = bRow+1, eRow-1 {
@@ -337,7 +372,7 @@ func (m *Matrix[T]) MirrorRows() error {
 // MirrorColumns reverse column order
 func (m *Matrix[T]) MirrorColumns() error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
 	for bCol, eCol := 0, m.colCount-1; bCol < eCol; bCol, eCol = bCol+1, eCol-1 {
@@ -354,7 +389,7 @@ func (m *Matrix[T]) MirrorColumns() error {
 // Rotate rotate matrix to 90 grad
 func (m *Matrix[T]) Rotate() error {
 	if m == nil {
-		return errors.New(NilMatrixObject)
+		return ErrNilMatrix
 	}
 
 	err := m.Transpose()
@@ -369,3 +404,22 @@ func (m *Matrix[T]) Rotate() error {
 
 	return nil
 }
+
+// RotateCCW rotate matrix to 90 grad counter-clockwise
+func (m *Matrix[T]) RotateCCW() error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	err := m.Transpose()
+	if err != nil {
+		return err
+	}
+
+	err = m.MirrorRows()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -522,6 +522,59 @@ func TestSetBatch(t *testing.T) {
 
 }
 
+func TestSetBatchAtomic(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	m, err := NewMatrix(d, 3, 3)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = m.SetBatch(666, makeIter([]struct{ row, column int }{{0, 0}, {1, 1}, {3, 0}}))
+	if err.Error() != InvalidIndexError {
+		t.Fatal("check invalid index fail")
+	}
+	exp := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestConsumedIterator(t *testing.T) {
+	d := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	m, err := NewMatrix(d, 3, 3)
+	if err != nil {
+		t.Error(err)
+	}
+
+	points := makeIter([]struct{ row, column int }{{0, 0}, {2, 2}})
+	for points.Next() {
+	}
+
+	actual, err := m.AnyOfPoints(points, func(cell int) bool { return cell == 9 })
+	if err != nil {
+		t.Error(err)
+	}
+	if !actual {
+		t.Errorf("act: %t exp: %t", actual, true)
+	}
+
+	for points.Next() {
+	}
+	err = m.SetBatch(0, points)
+	if err != nil {
+		t.Error(err)
+	}
+	exp := []int{0, 2, 3, 4, 5, 6, 7, 8, 0}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	shape := NewMatrixFromPoints(points, 1)
+	if shape.rowCount != 3 || shape.colCount != 3 {
+		t.Error("check row and colun size")
+	}
+}
+
 type Points struct {
 	data  []struct{ row, column int }
 	index int
@@ -817,6 +870,42 @@ func TestRotate5x2(t *testing.T) {
 	}
 }
 
+func TestRotateCCW(t *testing.T) {
+	var m *Matrix[int]
+	err := m.RotateCCW()
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	d := []int{
+		1, 2, 3, 4, 5,
+		6, 7, 8, 9, 10}
+	m, err = NewMatrix(d, 2, 5)
+	if err != nil {
+		t.Error(err)
+	}
+	m.RotateCCW()
+
+	exp := []int{
+		5, 10,
+		4, 9,
+		3, 8,
+		2, 7,
+		1, 6}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if m.rowCount != 5 || m.colCount != 2 {
+		t.Error("check row and colun size")
+	}
+
+	m.Rotate()
+	if cmpRes := compareSlices(m.cells, d); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
 func TestNewMatrixFromPoints3x2(t *testing.T) {
 	m := NewMatrixFromPoints(&Points{[]struct{ row, column int }{{0, 0}, {1, 0}, {1, 1}, {1, 2}}, 0}, 1)
 
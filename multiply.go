@@ -0,0 +1,123 @@
+package matrix
+
+import (
+	"runtime"
+	"sync"
+)
+
+// mulBlockSize is a tile side for cache-blocked multiplication
+const mulBlockSize = 64
+
+// checkMulShapes check matrices exist and `a` columns count equals `b` rows count
+func checkMulShapes[N Number](a, b *Matrix[N]) error {
+	if a == nil || b == nil {
+		return ErrNilMatrix
+	}
+	if a.colCount != b.rowCount {
+		return dimensionError(a, b)
+	}
+	return nil
+}
+
+// Mul make new matrix with product of `a` and `b` using cache-blocked kernel
+func Mul[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	return MulBlocked(a, b)
+}
+
+// MulNaive make new matrix with product of `a` and `b` using plain triple loop
+func MulNaive[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	if err := checkMulShapes(a, b); err != nil {
+		return nil, err
+	}
+
+	res := NewZeroMatrix[N](a.rowCount, b.colCount)
+	for i := 0; i < a.rowCount; i++ {
+		for j := 0; j < b.colCount; j++ {
+			var sum N
+			for k := 0; k < a.colCount; k++ {
+				sum += a.cells[calcIndex(i, k, a.colCount)] * b.cells[calcIndex(k, j, b.colCount)]
+			}
+			res.cells[calcIndex(i, j, res.colCount)] = sum
+		}
+	}
+	return res, nil
+}
+
+// MulBlocked make new matrix with product of `a` and `b`.
+// Matrices are processed by square tiles to keep working set in cache
+func MulBlocked[N Number](a, b *Matrix[N]) (*Matrix[N], error) {
+	if err := checkMulShapes(a, b); err != nil {
+		return nil, err
+	}
+
+	res := NewZeroMatrix[N](a.rowCount, b.colCount)
+	mulBlocked(a, b, res, 0, a.rowCount)
+	return res, nil
+}
+
+// MulParallel make new matrix with product of `a` and `b`.
+// Result rows are split into stripes computed by `workers` goroutines
+// with cache-blocked kernel. Non-positive `workers` means runtime.GOMAXPROCS(0)
+func MulParallel[N Number](a, b *Matrix[N], workers int) (*Matrix[N], error) {
+	if err := checkMulShapes(a, b); err != nil {
+		return nil, err
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	res := NewZeroMatrix[N](a.rowCount, b.colCount)
+	runStripes(a.rowCount, workers, func(from, to int) {
+		mulBlocked(a, b, res, from, to)
+	})
+
+	return res, nil
+}
+
+// runStripes split rows [0, rows) into at most `workers` stripes and call `f`
+// for each stripe in its own goroutine. Waits for all stripes to finish.
+// `workers` below 2 means single call in current goroutine
+func runStripes(rows, workers int, f func(from, to int)) {
+	workers = min(workers, rows)
+	if workers <= 1 {
+		f(0, rows)
+		return
+	}
+
+	stripe := (rows + workers - 1) / workers
+	var wg sync.WaitGroup
+	for from := 0; from < rows; from += stripe {
+		to := min(from+stripe, rows)
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			f(from, to)
+		}(from, to)
+	}
+	wg.Wait()
+}
+
+// mulBlocked accumulate product of `a` rows [rowFrom, rowTo) and `b` into `res`
+func mulBlocked[N Number](a, b, res *Matrix[N], rowFrom, rowTo int) {
+	n, m := a.colCount, b.colCount
+	for ii := rowFrom; ii < rowTo; ii += mulBlockSize {
+		iEnd := min(ii+mulBlockSize, rowTo)
+		for kk := 0; kk < n; kk += mulBlockSize {
+			kEnd := min(kk+mulBlockSize, n)
+			for jj := 0; jj < m; jj += mulBlockSize {
+				jEnd := min(jj+mulBlockSize, m)
+				for i := ii; i < iEnd; i++ {
+					resRow := res.cells[i*m+jj : i*m+jEnd]
+					for k := kk; k < kEnd; k++ {
+						aik := a.cells[i*n+k]
+						bRow := b.cells[k*m+jj : k*m+jEnd]
+						for j, bkj := range bRow {
+							resRow[j] += aik * bkj
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package matrix
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestMul(t *testing.T) {
+	_, err := Mul(nil, NewZeroMatrix[int](2, 2))
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	_, err = Mul(NewZeroMatrix[int](2, 3), NewZeroMatrix[int](2, 3))
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatal("check dimension mismatch fail")
+	}
+
+	a, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+	b, _ := NewMatrix([]int{
+		7, 8,
+		9, 10,
+		11, 12}, 3, 2)
+	exp := []int{
+		58, 64,
+		139, 154}
+
+	muls := map[string]func(a, b *Matrix[int]) (*Matrix[int], error){
+		"Mul":      Mul[int],
+		"naive":    MulNaive[int],
+		"blocked":  MulBlocked[int],
+		"parallel": func(a, b *Matrix[int]) (*Matrix[int], error) { return MulParallel(a, b, 4) },
+	}
+	for name, mul := range muls {
+		t.Run(name, func(t *testing.T) {
+			act, err := mul(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act.rowCount != 2 || act.colCount != 2 {
+				t.Error("check row and colun size")
+			}
+			if cmpRes := compareSlices(act.cells, exp); cmpRes != nil {
+				t.Error(cmpRes)
+			}
+		})
+	}
+}
+
+func randomMatrix(rows, columns int, seed int64) *Matrix[int] {
+	r := rand.New(rand.NewSource(seed))
+	m := NewZeroMatrix[int](rows, columns)
+	for i := range m.cells {
+		m.cells[i] = r.Intn(100) - 50
+	}
+	return m
+}
+
+func TestMulKernelsAgree(t *testing.T) {
+	a := randomMatrix(131, 77, 1)
+	b := randomMatrix(77, 150, 2)
+
+	exp, err := MulNaive(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act, err := MulBlocked(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(act.cells, exp.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	for _, workers := range []int{0, 1, 3, 1000} {
+		act, err = MulParallel(a, b, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmpRes := compareSlices(act.cells, exp.cells); cmpRes != nil {
+			t.Errorf("workers %d: %v", workers, cmpRes)
+		}
+	}
+}
+
+func benchmarkMatrices(size int) (*Matrix[float64], *Matrix[float64]) {
+	r := rand.New(rand.NewSource(1))
+	a := NewZeroMatrix[float64](size, size)
+	b := NewZeroMatrix[float64](size, size)
+	for i := range a.cells {
+		a.cells[i] = r.Float64()
+		b.cells[i] = r.Float64()
+	}
+	return a, b
+}
+
+func BenchmarkMulNaive(b *testing.B) {
+	x, y := benchmarkMatrices(256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MulNaive(x, y)
+	}
+}
+
+func BenchmarkMulBlocked(b *testing.B) {
+	x, y := benchmarkMatrices(256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MulBlocked(x, y)
+	}
+}
+
+func BenchmarkMulParallel(b *testing.B) {
+	x, y := benchmarkMatrices(256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MulParallel(x, y, 0)
+	}
+}
@@ -0,0 +1,128 @@
+package matrix
+
+// Neighborhood is a set of offsets from a cell to its neighbours.
+// Any offsets may be used as custom neighbourhood
+type Neighborhood []Point
+
+// VonNeumann make neighbourhood of cells within Manhattan distance `r`, excluding center
+func VonNeumann(r int) Neighborhood {
+	res := make(Neighborhood, 0)
+	for row := -r; row <= r; row++ {
+		for col := -r; col <= r; col++ {
+			if (row != 0 || col != 0) && max(row, -row)+max(col, -col) <= r {
+				res = append(res, Point{row, col})
+			}
+		}
+	}
+	return res
+}
+
+// Moore make neighbourhood of cells within Chebyshev distance `r`, excluding center
+func Moore(r int) Neighborhood {
+	res := make(Neighborhood, 0)
+	for row := -r; row <= r; row++ {
+		for col := -r; col <= r; col++ {
+			if row != 0 || col != 0 {
+				res = append(res, Point{row, col})
+			}
+		}
+	}
+	return res
+}
+
+// EdgePolicy control access to cells outside matrix
+type EdgePolicy int
+
+// Edge policies
+const (
+	// EdgeClip skip cells outside matrix
+	EdgeClip EdgePolicy = iota
+	// EdgeWrap wrap coordinates around, as on torus
+	EdgeWrap
+	// EdgeReflect mirror coordinates at the edge without repeating edge cell, so -1 maps to 1
+	EdgeReflect
+	// EdgeConstant use constant value for cells outside matrix
+	EdgeConstant
+)
+
+func (p EdgePolicy) valid() bool {
+	return p >= EdgeClip && p <= EdgeConstant
+}
+
+// resolve map coordinate `x` into [0, n) by policy.
+// Return false if coordinate stays outside (for EdgeClip and EdgeConstant)
+func (p EdgePolicy) resolve(x, n int) (int, bool) {
+	if x >= 0 && x < n {
+		return x, true
+	}
+	if n == 0 {
+		return 0, false
+	}
+
+	switch p {
+	case EdgeWrap:
+		return (x%n + n) % n, true
+	case EdgeReflect:
+		if n == 1 {
+			return 0, true
+		}
+		period := 2 * (n - 1)
+		x = (x%period + period) % period
+		if x >= n {
+			x = period - x
+		}
+		return x, true
+	}
+	return 0, false
+}
+
+// resolveIndex get slice index of cell [row, col] resolved by `policy`.
+// Return false if cell stays outside matrix
+func (m *Matrix[T]) resolveIndex(row, col int, policy EdgePolicy) (int, bool) {
+	row, rowOk := policy.resolve(row, m.rowCount)
+	col, colOk := policy.resolve(col, m.colCount)
+	if !rowOk || !colOk {
+		return 0, false
+	}
+	return calcIndex(row, col, m.colCount), true
+}
+
+// Neighbor is a neighbour cell. Point is a cell coordinates after edge policy
+// is applied, for EdgeConstant cells outside matrix keep their coordinates
+type Neighbor[T any] struct {
+	Point  Point
+	Offset Point
+	Value  T
+}
+
+// Neighbors get neighbours of cell `p` by offsets from `kind` in their order.
+// Cells outside matrix are handled by `policy`, `constant` is used only with EdgeConstant
+func (m *Matrix[T]) Neighbors(p Point, kind Neighborhood, policy EdgePolicy, constant T) ([]Neighbor[T], error) {
+	if m == nil {
+		return []Neighbor[T]{}, ErrNilMatrix
+	}
+	if !policy.valid() {
+		return []Neighbor[T]{}, ErrInvalidArgument
+	}
+	if _, err := m.index(p.Row, p.Column); err != nil {
+		return []Neighbor[T]{}, err
+	}
+
+	return m.appendNeighbors(make([]Neighbor[T], 0, len(kind)), p.Row, p.Column, kind, policy, constant), nil
+}
+
+// appendNeighbors append neighbours of cell [row, col] to `dst` and return extended slice
+func (m *Matrix[T]) appendNeighbors(dst []Neighbor[T], row, col int, kind Neighborhood, policy EdgePolicy, constant T) []Neighbor[T] {
+	for _, offset := range kind {
+		r, c := row+offset.Row, col+offset.Column
+		i, ok := m.resolveIndex(r, c, policy)
+		switch {
+		case ok:
+			r, c, _ = m.pos(i)
+			dst = append(dst, Neighbor[T]{Point{r, c}, offset, m.cells[i]})
+		case policy == EdgeConstant:
+			dst = append(dst, Neighbor[T]{Point{r, c}, offset, constant})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,98 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestNeighborhoods(t *testing.T) {
+	exp := Neighborhood{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+	if cmpRes := compareSlices(VonNeumann(1), exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	exp = Neighborhood{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+	if cmpRes := compareSlices(Moore(1), exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if n := len(VonNeumann(2)); n != 12 {
+		t.Errorf("act: %d exp: 12", n)
+	}
+	if n := len(Moore(2)); n != 24 {
+		t.Errorf("act: %d exp: 24", n)
+	}
+	if n := len(Moore(0)); n != 0 {
+		t.Errorf("act: %d exp: 0", n)
+	}
+}
+
+func TestEdgePolicyResolve(t *testing.T) {
+	test := func(p EdgePolicy, x, n, exp int, expOk bool) {
+		act, ok := p.resolve(x, n)
+		if ok != expOk || ok && act != exp {
+			t.Errorf("policy %d resolve(%d, %d): act: %d, %t exp: %d, %t", p, x, n, act, ok, exp, expOk)
+		}
+	}
+
+	test(EdgeClip, 2, 4, 2, true)
+	test(EdgeClip, -1, 4, 0, false)
+	test(EdgeConstant, 4, 4, 0, false)
+	test(EdgeWrap, -1, 4, 3, true)
+	test(EdgeWrap, 9, 4, 1, true)
+	test(EdgeReflect, -1, 4, 1, true)
+	test(EdgeReflect, -3, 4, 3, true)
+	test(EdgeReflect, -4, 4, 2, true)
+	test(EdgeReflect, 4, 4, 2, true)
+	test(EdgeReflect, 7, 4, 1, true)
+	test(EdgeReflect, -2, 1, 0, true)
+	test(EdgeWrap, 0, 0, 0, false)
+}
+
+func TestNeighbors(t *testing.T) {
+	var m *Matrix[int]
+	if _, err := m.Neighbors(Point{0, 0}, Moore(1), EdgeClip, 0); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	if _, err := m.Neighbors(Point{3, 0}, Moore(1), EdgeClip, 0); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if _, err := m.Neighbors(Point{0, 0}, Moore(1), EdgePolicy(7), 0); err.Error() != InvalidArgument {
+		t.Error("check invalid policy fail")
+	}
+
+	values := func(ns []Neighbor[int]) []int {
+		res := make([]int, 0, len(ns))
+		for _, n := range ns {
+			res = append(res, n.Value)
+		}
+		return res
+	}
+	test := func(p Point, kind Neighborhood, policy EdgePolicy, exp []int) {
+		ns, err := m.Neighbors(p, kind, policy, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmpRes := compareSlices(values(ns), exp); cmpRes != nil {
+			t.Errorf("%v policy %d: %v", p, policy, cmpRes)
+		}
+	}
+
+	test(Point{1, 1}, Moore(1), EdgeClip, []int{1, 2, 3, 4, 6, 7, 8, 9})
+	test(Point{0, 0}, Moore(1), EdgeClip, []int{2, 4, 5})
+	test(Point{0, 0}, VonNeumann(1), EdgeWrap, []int{7, 3, 2, 4})
+	test(Point{0, 0}, VonNeumann(1), EdgeReflect, []int{4, 2, 2, 4})
+	test(Point{0, 0}, VonNeumann(1), EdgeConstant, []int{-1, -1, 2, 4})
+	test(Point{2, 2}, Neighborhood{{0, 0}, {-2, 0}, {0, 1}}, EdgeClip, []int{9, 3})
+
+	ns, _ := m.Neighbors(Point{0, 0}, Neighborhood{{-1, 0}}, EdgeWrap, 0)
+	if ns[0].Point != (Point{2, 0}) || ns[0].Offset != (Point{-1, 0}) {
+		t.Errorf("act: %v exp: point {2 0} offset {-1 0}", ns[0])
+	}
+	ns, _ = m.Neighbors(Point{0, 0}, Neighborhood{{-1, 0}}, EdgeConstant, 0)
+	if ns[0].Point != (Point{-1, 0}) {
+		t.Errorf("act: %v exp: point {-1 0}", ns[0])
+	}
+}
@@ -0,0 +1,342 @@
+package matrix
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+)
+
+// Algorithm is a shortest path search algorithm
+type Algorithm int
+
+// Search algorithms
+const (
+	// AlgorithmAuto use BFS for uniform costs with Four connectivity,
+	// A* if heuristic is set and Dijkstra otherwise
+	AlgorithmAuto Algorithm = iota
+	// AlgorithmBFS minimize count of steps ignoring cell costs
+	AlgorithmBFS
+	// AlgorithmDijkstra minimize path cost
+	AlgorithmDijkstra
+	// AlgorithmAStar minimize path cost guided by heuristic
+	AlgorithmAStar
+)
+
+// DiagonalRule control diagonal steps around blocked cells with Eight connectivity
+type DiagonalRule int
+
+// Diagonal rules
+const (
+	// DiagonalAllow allow every diagonal step to passable cell
+	DiagonalAllow DiagonalRule = iota
+	// DiagonalNoSqueeze forbid diagonal step between two blocked side cells
+	DiagonalNoSqueeze
+	// DiagonalNoCorners forbid diagonal step if any side cell is blocked
+	DiagonalNoCorners
+)
+
+// Heuristic estimate path cost between two points. A* finds the cheapest path
+// only if heuristic is consistent: estimate for a cell never exceeds step cost
+// to its neighbour plus estimate for that neighbour, and estimate for target is 0
+type Heuristic func(a, b Point) float64
+
+// distances get absolute row and column distances between `a` and `b`
+func distances(a, b Point) (float64, float64) {
+	return math.Abs(float64(a.Row - b.Row)), math.Abs(float64(a.Column - b.Column))
+}
+
+// Manhattan get sum of row and column distances
+func Manhattan(a, b Point) float64 {
+	dr, dc := distances(a, b)
+	return dr + dc
+}
+
+// Euclidean get straight line distance
+func Euclidean(a, b Point) float64 {
+	return math.Hypot(distances(a, b))
+}
+
+// Chebyshev get max of row and column distances
+func Chebyshev(a, b Point) float64 {
+	dr, dc := distances(a, b)
+	return max(dr, dc)
+}
+
+// Octile get distance with diagonal steps costing Sqrt2
+func Octile(a, b Point) float64 {
+	dr, dc := distances(a, b)
+	return max(dr, dc) + (math.Sqrt2-1)*min(dr, dc)
+}
+
+// PathOptions control ShortestPath search
+type PathOptions struct {
+	// Connectivity of cells, zero value means Four
+	Connectivity Connectivity
+	// Diagonal rule for Eight connectivity
+	Diagonal DiagonalRule
+	// Algorithm of search
+	Algorithm Algorithm
+	// Heuristic for A*, nil means Manhattan for Four and Octile for Eight connectivity
+	// scaled by the lowest cost of passable cell
+	Heuristic Heuristic
+}
+
+// ShortestPath find the cheapest path from `from` to `to` including both ends.
+// `cost` get cost of entering a cell and report if cell is passable, costs must not be negative.
+// Diagonal step costs Sqrt2 times cost of entered cell. Return path with its cost
+// or ErrNoPath if `to` is unreachable
+func ShortestPath[T any](m *Matrix[T], from, to Point, cost func(T) (float64, bool), opts PathOptions) (*PointList, float64, error) {
+	if m == nil {
+		return NewPointList(), 0, ErrNilMatrix
+	}
+
+	s, err := newPathSearch(m, cost, opts)
+	if err != nil {
+		return NewPointList(), 0, err
+	}
+
+	start, err := m.index(from.Row, from.Column)
+	if err != nil {
+		return NewPointList(), 0, err
+	}
+	target, err := m.index(to.Row, to.Column)
+	if err != nil {
+		return NewPointList(), 0, err
+	}
+	if _, ok := s.passable(from.Row, from.Column); !ok {
+		return NewPointList(), 0, ErrNoPath
+	}
+	if _, ok := s.passable(to.Row, to.Column); !ok {
+		return NewPointList(), 0, ErrNoPath
+	}
+
+	lowest, uniform := s.costRange()
+	algorithm := opts.Algorithm
+	if algorithm == AlgorithmAuto {
+		switch {
+		case s.connectivity == Four && uniform:
+			algorithm = AlgorithmBFS
+		case opts.Heuristic != nil:
+			algorithm = AlgorithmAStar
+		default:
+			algorithm = AlgorithmDijkstra
+		}
+	}
+
+	switch algorithm {
+	case AlgorithmBFS:
+		err = s.bfs(start, target)
+	case AlgorithmDijkstra:
+		err = s.best(start, target, nil)
+	case AlgorithmAStar:
+		h := opts.Heuristic
+		if h == nil && lowest > 0 {
+			base := Manhattan
+			if s.connectivity == Eight {
+				base = Octile
+			}
+			h = func(a, b Point) float64 { return lowest * base(a, b) }
+		}
+		err = s.best(start, target, h)
+	default:
+		err = ErrInvalidArgument
+	}
+	if err != nil {
+		return NewPointList(), 0, err
+	}
+
+	if math.IsInf(s.dist[target], 1) {
+		return NewPointList(), 0, ErrNoPath
+	}
+
+	return s.path(target), s.dist[target], nil
+}
+
+type pathSearch[T any] struct {
+	m            *Matrix[T]
+	cost         func(T) (float64, bool)
+	connectivity Connectivity
+	offsets      []Point
+	diagonal     DiagonalRule
+	dist         []float64
+	prev         []int
+}
+
+func newPathSearch[T any](m *Matrix[T], cost func(T) (float64, bool), opts PathOptions) (*pathSearch[T], error) {
+	connectivity := opts.Connectivity
+	if connectivity == 0 {
+		connectivity = Four
+	}
+	offsets, err := connectivity.offsets()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Diagonal < DiagonalAllow || opts.Diagonal > DiagonalNoCorners {
+		return nil, ErrInvalidArgument
+	}
+
+	s := &pathSearch[T]{
+		m:            m,
+		cost:         cost,
+		connectivity: connectivity,
+		offsets:      offsets,
+		diagonal:     opts.Diagonal,
+		dist:         make([]float64, len(m.cells)),
+		prev:         make([]int, len(m.cells)),
+	}
+	for i := range s.dist {
+		s.dist[i] = math.Inf(1)
+		s.prev[i] = -1
+	}
+
+	return s, nil
+}
+
+// passable get cost of entering cell [row, col] and report if it is inside matrix and passable
+func (s *pathSearch[T]) passable(row, col int) (float64, bool) {
+	i, err := s.m.index(row, col)
+	if err != nil {
+		return 0, false
+	}
+	return s.cost(s.m.cells[i])
+}
+
+// costRange get the lowest cost of passable cell and report if every passable cell has the same cost
+func (s *pathSearch[T]) costRange() (float64, bool) {
+	lowest, highest, found := 0.0, 0.0, false
+	for _, cell := range s.m.cells {
+		c, ok := s.cost(cell)
+		if !ok {
+			continue
+		}
+		if !found {
+			lowest, highest, found = c, c, true
+			continue
+		}
+		lowest, highest = min(lowest, c), max(highest, c)
+	}
+	return lowest, lowest == highest
+}
+
+// neighbors call `f` for each cell reachable from cell `i` by one step with its step cost
+func (s *pathSearch[T]) neighbors(i int, f func(j int, step float64)) error {
+	row, col, _ := s.m.pos(i)
+	for _, offset := range s.offsets {
+		r, c := row+offset.Row, col+offset.Column
+		step, ok := s.passable(r, c)
+		if !ok {
+			continue
+		}
+		if step < 0 {
+			return ErrInvalidArgument
+		}
+
+		if offset.Row != 0 && offset.Column != 0 {
+			_, rowSide := s.passable(r, col)
+			_, colSide := s.passable(row, c)
+			if s.diagonal == DiagonalNoSqueeze && !rowSide && !colSide ||
+				s.diagonal == DiagonalNoCorners && (!rowSide || !colSide) {
+				continue
+			}
+			step *= math.Sqrt2
+		}
+
+		j, _ := s.m.index(r, c)
+		f(j, step)
+	}
+	return nil
+}
+
+func (s *pathSearch[T]) bfs(start, target int) error {
+	s.dist[start] = 0
+	queue := []int{start}
+	for len(queue) > 0 && queue[0] != target {
+		i := queue[0]
+		queue = queue[1:]
+		err := s.neighbors(i, func(j int, step float64) {
+			if !math.IsInf(s.dist[j], 1) {
+				return
+			}
+			s.dist[j] = s.dist[i] + step
+			s.prev[j] = i
+			queue = append(queue, j)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// best run Dijkstra search, or A* if heuristic `h` is not nil
+func (s *pathSearch[T]) best(start, target int, h Heuristic) error {
+	estimate := func(i int) float64 {
+		if h == nil {
+			return 0
+		}
+		row, col, _ := s.m.pos(i)
+		targetRow, targetCol, _ := s.m.pos(target)
+		return h(Point{row, col}, Point{targetRow, targetCol})
+	}
+
+	done := make([]bool, len(s.dist))
+	s.dist[start] = 0
+	queue := &pathQueue{{start, estimate(start)}}
+	for queue.Len() > 0 {
+		i := heap.Pop(queue).(pathItem).index
+		if i == target {
+			return nil
+		}
+		if done[i] {
+			continue
+		}
+		done[i] = true
+
+		err := s.neighbors(i, func(j int, step float64) {
+			if done[j] || s.dist[i]+step >= s.dist[j] {
+				return
+			}
+			s.dist[j] = s.dist[i] + step
+			s.prev[j] = i
+			heap.Push(queue, pathItem{j, s.dist[j] + estimate(j)})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// path collect points from start to `target` following search results
+func (s *pathSearch[T]) path(target int) *PointList {
+	points := make([]Point, 0)
+	for i := target; i != -1; i = s.prev[i] {
+		row, col, _ := s.m.pos(i)
+		points = append(points, Point{row, col})
+	}
+	slices.Reverse(points)
+	return NewPointList(points...)
+}
+
+type pathItem struct {
+	index    int
+	priority float64
+}
+
+// pathQueue is a min-heap of cells by priority
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int           { return len(q) }
+func (q pathQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q pathQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *pathQueue) Push(x any) {
+	*q = append(*q, x.(pathItem))
+}
+
+func (q *pathQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,204 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func gridFromRows(rows ...string) *Matrix[byte] {
+	m, _ := NewMatrix([]byte(strings.Join(rows, "")), len(rows), len(rows[0]))
+	return m
+}
+
+func gridCost(cell byte) (float64, bool) {
+	switch cell {
+	case '#':
+		return 0, false
+	case '~':
+		return 9, true
+	}
+	return 1, true
+}
+
+func TestHeuristics(t *testing.T) {
+	a, b := Point{1, 2}, Point{4, 6}
+	test := func(name string, act, exp float64) {
+		if math.Abs(act-exp) > 1e-12 {
+			t.Errorf("%s: act: %g exp: %g", name, act, exp)
+		}
+	}
+	test("Manhattan", Manhattan(a, b), 7)
+	test("Euclidean", Euclidean(a, b), 5)
+	test("Chebyshev", Chebyshev(a, b), 4)
+	test("Octile", Octile(a, b), 1+3*math.Sqrt2)
+}
+
+func TestShortestPathErrors(t *testing.T) {
+	_, _, err := ShortestPath(nil, Point{}, Point{}, gridCost, PathOptions{})
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m := gridFromRows(
+		"..#",
+		"..#",
+		"##.")
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{3, 0}, gridCost, PathOptions{}); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{2, 2}, gridCost, PathOptions{}); !errors.Is(err, ErrNoPath) {
+		t.Errorf("act: %v exp: %v", err, ErrNoPath)
+	}
+	if _, _, err = ShortestPath(m, Point{0, 2}, Point{0, 0}, gridCost, PathOptions{}); !errors.Is(err, ErrNoPath) {
+		t.Errorf("blocked start: act: %v exp: %v", err, ErrNoPath)
+	}
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{1, 1}, gridCost, PathOptions{Connectivity: 3}); err.Error() != InvalidArgument {
+		t.Error("check invalid connectivity fail")
+	}
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{1, 1}, gridCost, PathOptions{Algorithm: 9}); err.Error() != InvalidArgument {
+		t.Error("check invalid algorithm fail")
+	}
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{1, 1}, gridCost, PathOptions{Diagonal: 9}); err.Error() != InvalidArgument {
+		t.Error("check invalid diagonal rule fail")
+	}
+	negative := func(cell byte) (float64, bool) { return -1, true }
+	if _, _, err = ShortestPath(m, Point{0, 0}, Point{1, 1}, negative, PathOptions{Algorithm: AlgorithmDijkstra}); err.Error() != InvalidArgument {
+		t.Error("check negative cost fail")
+	}
+
+	path, cost, err := ShortestPath(m, Point{1, 1}, Point{1, 1}, gridCost, PathOptions{})
+	if err != nil || cost != 0 || path.Len() != 1 {
+		t.Errorf("act: %v, %g, %v exp: single point path", path.Points, cost, err)
+	}
+}
+
+func TestShortestPathFour(t *testing.T) {
+	m := gridFromRows(
+		".....",
+		".###.",
+		"...#.",
+		"~#...",
+		".....")
+
+	for _, alg := range []Algorithm{AlgorithmAuto, AlgorithmBFS, AlgorithmDijkstra, AlgorithmAStar} {
+		path, cost, err := ShortestPath(m, Point{2, 0}, Point{2, 4}, gridCost, PathOptions{Algorithm: alg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost != 6 || path.Len() != 7 {
+			t.Errorf("algorithm %d: act: %g, %d points exp: 6, 7 points", alg, cost, path.Len())
+		}
+		if first, last := path.Points[0], path.Points[path.Len()-1]; first != (Point{2, 0}) || last != (Point{2, 4}) {
+			t.Errorf("algorithm %d: path ends %v %v", alg, first, last)
+		}
+	}
+
+	// expensive cell is avoided by cost aware search
+	path, cost, _ := ShortestPath(m, Point{2, 0}, Point{4, 0}, gridCost, PathOptions{Algorithm: AlgorithmDijkstra})
+	if cost != 6 || path.Len() != 7 {
+		t.Errorf("act: %g, %d points exp: 6, 7 points", cost, path.Len())
+	}
+	path, cost, _ = ShortestPath(m, Point{2, 0}, Point{4, 0}, gridCost, PathOptions{Algorithm: AlgorithmBFS})
+	if cost != 10 || path.Len() != 3 {
+		t.Errorf("act: %g, %d points exp: 10, 3 points", cost, path.Len())
+	}
+
+	// path is usable with SetBatch
+	path, _, _ = ShortestPath(m, Point{0, 0}, Point{4, 4}, gridCost, PathOptions{})
+	if err := m.SetBatch('*', path); err != nil {
+		t.Fatal(err)
+	}
+	if count, _ := m.CountIf(func(cell byte) bool { return cell == '*' }); count != 9 {
+		t.Errorf("act: %d exp: 9", count)
+	}
+}
+
+func TestShortestPathEight(t *testing.T) {
+	m := gridFromRows(
+		".#.",
+		"#..",
+		"...")
+	sqrt2 := math.Sqrt2
+
+	test := func(rule DiagonalRule, exp float64) {
+		_, cost, err := ShortestPath(m, Point{0, 0}, Point{0, 2}, gridCost, PathOptions{Connectivity: Eight, Diagonal: rule})
+		if exp < 0 {
+			if !errors.Is(err, ErrNoPath) {
+				t.Errorf("rule %d: act: %v exp: %v", rule, err, ErrNoPath)
+			}
+			return
+		}
+		if err != nil || math.Abs(cost-exp) > 1e-12 {
+			t.Errorf("rule %d: act: %g, %v exp: %g", rule, cost, err, exp)
+		}
+	}
+	test(DiagonalAllow, 2*sqrt2)
+	test(DiagonalNoSqueeze, -1)
+	test(DiagonalNoCorners, -1)
+
+	m = gridFromRows(
+		".#.",
+		"...",
+		"...")
+	test(DiagonalNoSqueeze, 2*sqrt2)
+	test(DiagonalNoCorners, 4)
+}
+
+func TestShortestPathAStarMatchesDijkstra(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	cells := []byte(".....~~#")
+	for n := 0; n < 20; n++ {
+		m := NewZeroMatrix[byte](15, 20)
+		for i := range m.cells {
+			m.cells[i] = cells[r.Intn(len(cells))]
+		}
+		m.cells[0], m.cells[len(m.cells)-1] = '.', '.'
+		from, to := Point{0, 0}, Point{14, 19}
+
+		for _, connectivity := range []Connectivity{Four, Eight} {
+			opts := PathOptions{Connectivity: connectivity, Algorithm: AlgorithmDijkstra}
+			_, exp, expErr := ShortestPath(m, from, to, gridCost, opts)
+			opts.Algorithm = AlgorithmAStar
+			_, act, actErr := ShortestPath(m, from, to, gridCost, opts)
+			if !errors.Is(actErr, expErr) || math.Abs(act-exp) > 1e-9 {
+				t.Errorf("grid %d connectivity %d: act: %g, %v exp: %g, %v", n, connectivity, act, actErr, exp, expErr)
+			}
+		}
+	}
+}
+
+func TestShortestPathAStarCheapCells(t *testing.T) {
+	m := gridFromRows(
+		".....",
+		",,,,,")
+	cost := func(cell byte) (float64, bool) {
+		switch cell {
+		case ',':
+			return 0.1, true
+		case '0':
+			return 0, true
+		}
+		return 1, true
+	}
+
+	for _, connectivity := range []Connectivity{Four, Eight} {
+		opts := PathOptions{Connectivity: connectivity, Algorithm: AlgorithmDijkstra}
+		_, exp, _ := ShortestPath(m, Point{0, 0}, Point{0, 4}, cost, opts)
+		opts.Algorithm = AlgorithmAStar
+		_, act, err := ShortestPath(m, Point{0, 0}, Point{0, 4}, cost, opts)
+		if err != nil || math.Abs(act-exp) > 1e-12 {
+			t.Errorf("connectivity %d: act: %g, %v exp: %g", connectivity, act, err, exp)
+		}
+	}
+
+	// zero cost cells disable default heuristic
+	m.cells[7] = '0'
+	_, exp, _ := ShortestPath(m, Point{0, 0}, Point{0, 4}, cost, PathOptions{Algorithm: AlgorithmDijkstra})
+	_, act, err := ShortestPath(m, Point{0, 0}, Point{0, 4}, cost, PathOptions{Algorithm: AlgorithmAStar})
+	if err != nil || math.Abs(act-exp) > 1e-12 {
+		t.Errorf("act: %g, %v exp: %g", act, err, exp)
+	}
+}
@@ -0,0 +1,75 @@
+package matrix
+
+// Overlaps check if `piece` placed with its top left cell on [atRow, atCol] of `board`
+// collides with board: some non-empty piece cell lands on non-empty board cell
+// or outside the board. Empty piece cells are ignored, so they may hang outside
+func Overlaps[T any](board, piece *Matrix[T], atRow, atCol int, isEmpty func(T) bool) (bool, error) {
+	if board == nil || piece == nil {
+		return false, ErrNilMatrix
+	}
+
+	for i, cell := range piece.cells {
+		if isEmpty(cell) {
+			continue
+		}
+		row, col := atRow+i/piece.colCount, atCol+i%piece.colCount
+		j, err := board.index(row, col)
+		if err != nil {
+			return true, nil
+		}
+		if !isEmpty(board.cells[j]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// BlitOptions control how Blit copies cells
+type BlitOptions[T any] struct {
+	// Clip skip source cells outside destination instead of returning IndexError
+	Clip bool
+	// Transparent report source cells which are not copied, nil means every cell is copied
+	Transparent func(cell T) bool
+	// Merge combine destination and source cells, nil means source cell replaces destination one
+	Merge func(dst, src T) T
+}
+
+// Blit copy `src` into `dst` with top left cell of `src` placed on [atRow, atCol].
+// Without Clip option nothing is copied if any non-transparent source cell is
+// outside `dst`, and IndexError for the first such cell is returned
+func Blit[T any](dst, src *Matrix[T], atRow, atCol int, opts BlitOptions[T]) error {
+	if dst == nil || src == nil {
+		return ErrNilMatrix
+	}
+
+	visible := func(cell T) bool {
+		return opts.Transparent == nil || !opts.Transparent(cell)
+	}
+
+	if !opts.Clip {
+		for i, cell := range src.cells {
+			if !visible(cell) {
+				continue
+			}
+			if _, err := dst.index(atRow+i/src.colCount, atCol+i%src.colCount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, cell := range src.cells {
+		if !visible(cell) {
+			continue
+		}
+		j, err := dst.index(atRow+i/src.colCount, atCol+i%src.colCount)
+		if err != nil {
+			continue
+		}
+		if opts.Merge != nil {
+			cell = opts.Merge(dst.cells[j], cell)
+		}
+		dst.cells[j] = cell
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOverlaps(t *testing.T) {
+	isEmpty := func(cell int) bool { return cell == 0 }
+
+	_, err := Overlaps(nil, NewZeroMatrix[int](1, 1), 0, 0, isEmpty)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	board, _ := NewMatrix([]int{
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 1,
+		1, 0, 1, 1}, 4, 4)
+	piece, _ := NewMatrix([]int{
+		0, 2, 0,
+		2, 2, 2}, 2, 3)
+
+	test := func(row, col int, exp bool) {
+		act, err := Overlaps(board, piece, row, col, isEmpty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act != exp {
+			t.Errorf("[%d,%d]: act: %t exp: %t", row, col, act, exp)
+		}
+	}
+
+	test(0, 0, false)
+	test(1, 1, true)
+	test(2, 0, true)
+	test(1, 0, false)
+	test(-1, 0, true)
+	test(0, 2, true)
+	test(3, 0, true)
+
+	bar, _ := NewMatrix([]int{
+		0, 3,
+		0, 3}, 2, 2)
+	act, err := Overlaps(board, bar, 0, -1, isEmpty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act {
+		t.Error("empty cells outside board collide")
+	}
+}
+
+func TestBlit(t *testing.T) {
+	isEmpty := func(cell int) bool { return cell == 0 }
+
+	if err := Blit(NewZeroMatrix[int](1, 1), nil, 0, 0, BlitOptions[int]{}); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	board, _ := NewMatrix([]int{
+		0, 0, 0,
+		0, 1, 0,
+		1, 1, 0}, 3, 3)
+	piece, _ := NewMatrix([]int{
+		2, 2,
+		0, 2}, 2, 2)
+
+	err := Blit(board, piece, 2, 1, BlitOptions[int]{Transparent: isEmpty})
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Row != 3 || indexErr.Column != 2 {
+		t.Fatalf("act: %v exp: IndexError at [3,2]", err)
+	}
+	exp := []int{
+		0, 0, 0,
+		0, 1, 0,
+		1, 1, 0}
+	if cmpRes := compareSlices(board.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = Blit(board, piece, 0, 1, BlitOptions[int]{Transparent: isEmpty}); err != nil {
+		t.Fatal(err)
+	}
+	exp = []int{
+		0, 2, 2,
+		0, 1, 2,
+		1, 1, 0}
+	if cmpRes := compareSlices(board.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err = Blit(board, piece, 1, -1, BlitOptions[int]{}); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if err = Blit(board, piece, 1, -1, BlitOptions[int]{Clip: true}); err != nil {
+		t.Fatal(err)
+	}
+	exp = []int{
+		0, 2, 2,
+		2, 1, 2,
+		2, 1, 0}
+	if cmpRes := compareSlices(board.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	sum := func(dst, src int) int { return dst + src }
+	if err = Blit(board, piece, 1, 1, BlitOptions[int]{Clip: true, Merge: sum}); err != nil {
+		t.Fatal(err)
+	}
+	exp = []int{
+		0, 2, 2,
+		2, 3, 4,
+		2, 1, 2}
+	if cmpRes := compareSlices(board.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,106 @@
+package matrix
+
+import (
+	"iter"
+	"slices"
+)
+
+// Point is a cell position in matrix
+type Point struct {
+	Row    int
+	Column int
+}
+
+// Rect is a rectangular region of `Rows` x `Columns` cells starting from cell [Row, Column]
+type Rect struct {
+	Row     int
+	Column  int
+	Rows    int
+	Columns int
+}
+
+// PointList is a list of points implementing PairIterator,
+// where First is a row and Second is a column
+type PointList struct {
+	Points []Point
+	index  int
+}
+
+// NewPointList make list of `points`
+func NewPointList(points ...Point) *PointList {
+	return &PointList{Points: points}
+}
+
+// PointsFromFiltered make list of points from result of Matrix.Filtered
+func PointsFromFiltered(filtered []struct{ Row, Column int }) *PointList {
+	points := make([]Point, 0, len(filtered))
+	for _, p := range filtered {
+		points = append(points, Point(p))
+	}
+	return NewPointList(points...)
+}
+
+// CollectPoints make list of points from `seq`
+func CollectPoints(seq iter.Seq[Point]) *PointList {
+	return NewPointList(slices.Collect(seq)...)
+}
+
+// PairSeq get sequence of points from `points` iterator starting from its begin
+func PairSeq(points PairIterator) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		points.Begin()
+		for points.Next() {
+			if !yield(Point{points.First(), points.Second()}) {
+				return
+			}
+		}
+	}
+}
+
+// fromCurrent is a PairIterator which ignores Begin
+type fromCurrent struct {
+	PairIterator
+}
+
+// Begin do nothing to keep the current position
+func (fromCurrent) Begin() {}
+
+// FromCurrent wrap `points` so consumers read it from the current position
+// instead of rewinding to its begin
+func FromCurrent(points PairIterator) PairIterator {
+	return fromCurrent{points}
+}
+
+// Begin set iterator to begin
+func (l *PointList) Begin() {
+	l.index = 0
+}
+
+// Next iterate to the next point and return true if point exists
+func (l *PointList) Next() bool {
+	if l.index < len(l.Points) {
+		l.index++
+		return true
+	}
+	return false
+}
+
+// First get row of current point
+func (l *PointList) First() int {
+	return l.Points[l.index-1].Row
+}
+
+// Second get column of current point
+func (l *PointList) Second() int {
+	return l.Points[l.index-1].Column
+}
+
+// Len get count of points in list
+func (l *PointList) Len() int {
+	return len(l.Points)
+}
+
+// All get sequence of points in list
+func (l *PointList) All() iter.Seq[Point] {
+	return slices.Values(l.Points)
+}
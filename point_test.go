@@ -0,0 +1,129 @@
+package matrix
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPointList(t *testing.T) {
+	var it PairIterator = NewPointList(Point{0, 1}, Point{2, 3})
+
+	var act []Point
+	it.Begin()
+	for it.Next() {
+		act = append(act, Point{it.First(), it.Second()})
+	}
+	if cmpRes := compareSlices(act, []Point{{0, 1}, {2, 3}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if it.Next() {
+		t.Error("consumed list has next point")
+	}
+
+	empty := NewPointList()
+	if empty.Next() || empty.Len() != 0 {
+		t.Error("empty list has points")
+	}
+}
+
+func TestPointListComposition(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+
+	filtered, _ := m.Filtered(func(cell int) bool { return cell%2 == 0 })
+	points := PointsFromFiltered(filtered)
+	if cmpRes := compareSlices(points.Points, []Point{{0, 1}, {1, 0}, {1, 2}, {2, 1}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	if err := m.SetBatch(0, points); err != nil {
+		t.Fatal(err)
+	}
+	exp := []int{
+		1, 0, 3,
+		0, 5, 0,
+		7, 0, 9}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	diagonal := NewPointList()
+	for p := range m.Diagonal() {
+		diagonal.Points = append(diagonal.Points, p)
+	}
+	found, err := m.AnyOfPoints(diagonal, func(cell int) bool { return cell == 5 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Error("act: false exp: true")
+	}
+
+	odd, err := m.FilteredPoints(func(cell int) bool { return cell%2 == 1 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := NewMatrixFromPoints(odd, 1)
+	if cmpRes := compareSlices(shape.cells, []int{1, 0, 1, 0, 1, 0, 1, 0, 1}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	var nilMatrix *Matrix[int]
+	if _, err = nilMatrix.FilteredPoints(func(cell int) bool { return true }); err.Error() != NilMatrixObject {
+		t.Error("check nil object fail")
+	}
+}
+
+func TestPairSeq(t *testing.T) {
+	it := makeIter([]struct{ row, column int }{{1, 2}, {3, 4}, {5, 6}})
+	it.Next()
+	it.Next()
+
+	act := slices.Collect(PairSeq(it))
+	if cmpRes := compareSlices(act, []Point{{1, 2}, {3, 4}, {5, 6}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	list := CollectPoints(NewPointList(act...).All())
+	if cmpRes := compareSlices(list.Points, act); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestFromCurrent(t *testing.T) {
+	m := NewZeroMatrix[int](3, 3)
+
+	points := NewPointList(Point{0, 0}, Point{1, 1}, Point{2, 2})
+	points.Next()
+
+	if err := m.SetBatch(1, FromCurrent(points)); err != nil {
+		t.Fatal(err)
+	}
+	exp := []int{
+		0, 0, 0,
+		0, 1, 0,
+		0, 0, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	found, err := m.AnyOfPoints(FromCurrent(points), func(cell int) bool { return cell == 1 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Error("consumed iterator is rewound")
+	}
+
+	points.Begin()
+	points.Next()
+	shape := NewMatrixFromPoints(FromCurrent(points), 5)
+	if shape.rowCount != 3 || shape.colCount != 3 {
+		t.Error("check row and colun size")
+	}
+	if cmpRes := compareSlices(shape.cells, []int{0, 0, 0, 0, 5, 0, 0, 0, 5}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
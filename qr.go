@@ -0,0 +1,155 @@
+package matrix
+
+// QR is a Householder factorization A = QR of matrix A with at least as many
+// rows as columns, where Q has orthonormal columns and R is upper triangular
+type QR[F Float] struct {
+	// qr store Householder vectors on and below diagonal and R above it
+	qr *Matrix[F]
+	// rDiag is the diagonal of R
+	rDiag []F
+	// tol is the threshold for zero diagonal of R
+	tol F
+}
+
+// NewQR factorize matrix `m` with rows count not less than columns count.
+// Rank deficient matrix is factorized too, but solving with its
+// factorization returns SingularError
+func NewQR[F Float](m *Matrix[F]) (*QR[F], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+	if m.rowCount < m.colCount {
+		return nil, ErrUnderdetermined
+	}
+
+	qr := m.clone()
+	rows, n := qr.rowCount, qr.colCount
+	a := qr.cells
+	f := &QR[F]{qr: qr, rDiag: make([]F, n), tol: F(rows) * epsilon[F]() * maxAbs(a)}
+
+	for k := 0; k < n; k++ {
+		var norm F
+		for i := k; i < rows; i++ {
+			norm = hypot(norm, a[i*n+k])
+		}
+
+		if norm != 0 {
+			if a[k*n+k] < 0 {
+				norm = -norm
+			}
+			for i := k; i < rows; i++ {
+				a[i*n+k] /= norm
+			}
+			a[k*n+k]++
+
+			for j := k + 1; j < n; j++ {
+				var s F
+				for i := k; i < rows; i++ {
+					s += a[i*n+k] * a[i*n+j]
+				}
+				s = -s / a[k*n+k]
+				for i := k; i < rows; i++ {
+					a[i*n+j] += s * a[i*n+k]
+				}
+			}
+		}
+		f.rDiag[k] = -norm
+	}
+
+	return f, nil
+}
+
+// IsFullRank report if factorized matrix has full column rank
+func (f *QR[F]) IsFullRank() bool {
+	return f.rankDeficiency() < 0
+}
+
+// rankDeficiency get the first column with zero diagonal of R or -1
+func (f *QR[F]) rankDeficiency() int {
+	for j, d := range f.rDiag {
+		if abs(d) <= f.tol {
+			return j
+		}
+	}
+	return -1
+}
+
+// Q get factor with orthonormal columns, it has the same size as A
+func (f *QR[F]) Q() *Matrix[F] {
+	rows, n := f.qr.rowCount, f.qr.colCount
+	a := f.qr.cells
+	q := NewZeroMatrix[F](rows, n)
+	for k := n - 1; k >= 0; k-- {
+		q.cells[k*n+k] = 1
+		for j := k; j < n; j++ {
+			if a[k*n+k] == 0 {
+				continue
+			}
+			var s F
+			for i := k; i < rows; i++ {
+				s += a[i*n+k] * q.cells[i*n+j]
+			}
+			s = -s / a[k*n+k]
+			for i := k; i < rows; i++ {
+				q.cells[i*n+j] += s * a[i*n+k]
+			}
+		}
+	}
+	return q
+}
+
+// R get upper triangular square factor
+func (f *QR[F]) R() *Matrix[F] {
+	n := f.qr.colCount
+	r := NewZeroMatrix[F](n, n)
+	for i := 0; i < n; i++ {
+		r.cells[i*n+i] = f.rDiag[i]
+		for j := i + 1; j < n; j++ {
+			r.cells[i*n+j] = f.qr.cells[i*n+j]
+		}
+	}
+	return r
+}
+
+// Solve get X minimizing ||AX - `b`|| in least-squares sense
+func (f *QR[F]) Solve(b *Matrix[F]) (*Matrix[F], error) {
+	if b == nil {
+		return nil, ErrNilMatrix
+	}
+	if b.rowCount != f.qr.rowCount {
+		return nil, dimensionError(f.qr, b)
+	}
+	if col := f.rankDeficiency(); col >= 0 {
+		return nil, &SingularError{Column: col}
+	}
+
+	rows, n, cols := f.qr.rowCount, f.qr.colCount, b.colCount
+	a := f.qr.cells
+	qtb := b.clone()
+
+	for c := 0; c < cols; c++ {
+		for k := 0; k < n; k++ {
+			var s F
+			for i := k; i < rows; i++ {
+				s += a[i*n+k] * qtb.cells[i*cols+c]
+			}
+			s = -s / a[k*n+k]
+			for i := k; i < rows; i++ {
+				qtb.cells[i*cols+c] += s * a[i*n+k]
+			}
+		}
+	}
+
+	x := NewZeroMatrix[F](n, cols)
+	for c := 0; c < cols; c++ {
+		for i := n - 1; i >= 0; i-- {
+			sum := qtb.cells[i*cols+c]
+			for j := i + 1; j < n; j++ {
+				sum -= a[i*n+j] * x.cells[j*cols+c]
+			}
+			x.cells[i*cols+c] = sum / f.rDiag[i]
+		}
+	}
+
+	return x, nil
+}
@@ -0,0 +1,103 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQR(t *testing.T) {
+	_, err := NewQR[float64](nil)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	_, err = NewQR(NewZeroMatrix[float64](2, 3))
+	if !errors.Is(err, ErrUnderdetermined) {
+		t.Fatal("check underdetermined fail")
+	}
+
+	m, _ := NewMatrix([]float64{
+		12, -51, 4,
+		6, 167, -68,
+		-4, 24, -41,
+		1, 1, 1}, 4, 3)
+	f, err := NewQR(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.IsFullRank() {
+		t.Error("check full rank fail")
+	}
+
+	q, r := f.Q(), f.R()
+	if q.rowCount != 4 || q.colCount != 3 || r.rowCount != 3 || r.colCount != 3 {
+		t.Fatal("check row and colun size")
+	}
+
+	qr, _ := Mul(q, r)
+	if cmpRes := compareFloats(qr.cells, m.cells, 1e-10); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	qt := q.clone()
+	qt.Transpose()
+	qtq, _ := Mul(qt, q)
+	if cmpRes := compareFloats(qtq.cells, Identity[float64](3).cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	for i := 1; i < 3; i++ {
+		for j := 0; j < i; j++ {
+			if v, _ := r.Get(i, j); v != 0 {
+				t.Errorf("R is not upper triangular at [%d,%d]", i, j)
+			}
+		}
+	}
+}
+
+func TestQRSolve(t *testing.T) {
+	m, _ := NewMatrix([]float32{
+		1, 0,
+		1, 1,
+		1, 2,
+		1, 3}, 4, 2)
+	f, err := NewQR(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.Solve(NewZeroMatrix[float32](3, 1))
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("check dimension mismatch fail")
+	}
+
+	b, _ := NewMatrix([]float32{
+		1, 2,
+		3, 5,
+		4, 8,
+		4, 11}, 4, 2)
+	x, err := f.Solve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(x.cells, []float32{1.5, 2, 1, 3}, 1e-5); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	deficient, _ := NewMatrix([]float64{
+		1, 2,
+		2, 4,
+		3, 6}, 3, 2)
+	fd, err := NewQR(deficient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fd.IsFullRank() {
+		t.Error("check rank deficiency fail")
+	}
+	_, err = fd.Solve(NewZeroMatrix[float64](3, 1))
+	var singularErr *SingularError
+	if !errors.As(err, &singularErr) || singularErr.Column != 1 {
+		t.Errorf("act: %v exp: SingularError at column 1", err)
+	}
+}
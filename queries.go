@@ -0,0 +1,213 @@
+package matrix
+
+// rowCells get cells of `row` sharing memory with matrix
+func (m *Matrix[T]) rowCells(row int) ([]T, error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+	if row < 0 || row >= m.rowCount {
+		return nil, m.rowError(row)
+	}
+	return m.cells[row*m.colCount : (row+1)*m.colCount], nil
+}
+
+// countCells count `cells` which satisfy `f`
+func countCells[T any](cells []T, f func(cell T) bool) int {
+	count := 0
+	for _, cell := range cells {
+		if f(cell) {
+			count++
+		}
+	}
+	return count
+}
+
+// anyOfCells check if `f` success for any of `cells`
+func anyOfCells[T any](cells []T, f func(cell T) bool) bool {
+	for _, cell := range cells {
+		if f(cell) {
+			return true
+		}
+	}
+	return false
+}
+
+// allOfCells check if `f` success for each of `cells`
+func allOfCells[T any](cells []T, f func(cell T) bool) bool {
+	for _, cell := range cells {
+		if !f(cell) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyOfRow check if `f` success for any value on `row`
+func (m *Matrix[T]) AnyOfRow(row int, f func(cell T) bool) (bool, error) {
+	cells, err := m.rowCells(row)
+	if err != nil {
+		return false, err
+	}
+	return anyOfCells(cells, f), nil
+}
+
+// AnyOfColumn check if `f` success for any value on `col`
+func (m *Matrix[T]) AnyOfColumn(col int, f func(cell T) bool) (bool, error) {
+	if m == nil {
+		return false, ErrNilMatrix
+	}
+	if col < 0 || col >= m.colCount {
+		return false, m.columnError(col)
+	}
+
+	for cell := range m.Column(col) {
+		if f(cell) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NoneOfRow check if `f` fails for each value on `row`
+func (m *Matrix[T]) NoneOfRow(row int, f func(cell T) bool) (bool, error) {
+	found, err := m.AnyOfRow(row, f)
+	return !found && err == nil, err
+}
+
+// NoneOfColumn check if `f` fails for each value on `col`
+func (m *Matrix[T]) NoneOfColumn(col int, f func(cell T) bool) (bool, error) {
+	found, err := m.AnyOfColumn(col, f)
+	return !found && err == nil, err
+}
+
+// AllOfPoints check if `f` success for each of `points`
+func (m *Matrix[T]) AllOfPoints(points PairIterator, f func(cell T) bool) (bool, error) {
+	found, err := m.AnyOfPoints(points, func(cell T) bool { return !f(cell) })
+	return !found && err == nil, err
+}
+
+// NoneOfPoints check if `f` fails for each of `points`
+func (m *Matrix[T]) NoneOfPoints(points PairIterator, f func(cell T) bool) (bool, error) {
+	found, err := m.AnyOfPoints(points, f)
+	return !found && err == nil, err
+}
+
+// AllOf check if `f` success for each cell
+func (m *Matrix[T]) AllOf(f func(cell T) bool) (bool, error) {
+	if m == nil {
+		return false, ErrNilMatrix
+	}
+	return allOfCells(m.cells, f), nil
+}
+
+// AnyOf check if `f` success for any cell
+func (m *Matrix[T]) AnyOf(f func(cell T) bool) (bool, error) {
+	if m == nil {
+		return false, ErrNilMatrix
+	}
+	return anyOfCells(m.cells, f), nil
+}
+
+// NoneOf check if `f` fails for each cell
+func (m *Matrix[T]) NoneOf(f func(cell T) bool) (bool, error) {
+	if m == nil {
+		return false, ErrNilMatrix
+	}
+	return !anyOfCells(m.cells, f), nil
+}
+
+// CountIf count cells which satisfy `f`
+func (m *Matrix[T]) CountIf(f func(cell T) bool) (int, error) {
+	if m == nil {
+		return 0, ErrNilMatrix
+	}
+	return countCells(m.cells, f), nil
+}
+
+// CountIfRow count cells on `row` which satisfy `f`
+func (m *Matrix[T]) CountIfRow(row int, f func(cell T) bool) (int, error) {
+	cells, err := m.rowCells(row)
+	if err != nil {
+		return 0, err
+	}
+	return countCells(cells, f), nil
+}
+
+// CountIfColumn count cells on `col` which satisfy `f`
+func (m *Matrix[T]) CountIfColumn(col int, f func(cell T) bool) (int, error) {
+	if m == nil {
+		return 0, ErrNilMatrix
+	}
+	if col < 0 || col >= m.colCount {
+		return 0, m.columnError(col)
+	}
+
+	count := 0
+	for cell := range m.Column(col) {
+		if f(cell) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// FindFirst get position of the first cell (row by row) which satisfy `f`.
+// Second result is false if there is no such cell
+func (m *Matrix[T]) FindFirst(f func(cell T) bool) (Point, bool, error) {
+	if m == nil {
+		return Point{}, false, ErrNilMatrix
+	}
+
+	for p, cell := range m.All() {
+		if f(cell) {
+			return p, true, nil
+		}
+	}
+	return Point{}, false, nil
+}
+
+// FindAll get positions of all cells (row by row) which satisfy `f`
+func (m *Matrix[T]) FindAll(f func(cell T) bool) ([]Point, error) {
+	if m == nil {
+		return []Point{}, ErrNilMatrix
+	}
+
+	res := make([]Point, 0)
+	for p, cell := range m.All() {
+		if f(cell) {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+// RowsAllOf get indices of rows where each cell satisfy `f`, e.g. completed lines
+func (m *Matrix[T]) RowsAllOf(f func(cell T) bool) ([]int, error) {
+	if m == nil {
+		return []int{}, ErrNilMatrix
+	}
+
+	res := make([]int, 0)
+	for row, cells := range m.Rows() {
+		if allOfCells(cells, f) {
+			res = append(res, row)
+		}
+	}
+	return res, nil
+}
+
+// ColumnsAllOf get indices of columns where each cell satisfy `f`
+func (m *Matrix[T]) ColumnsAllOf(f func(cell T) bool) ([]int, error) {
+	if m == nil {
+		return []int{}, ErrNilMatrix
+	}
+
+	res := make([]int, 0)
+	for col := 0; col < m.colCount; col++ {
+		count, _ := m.CountIfColumn(col, f)
+		if count == m.rowCount {
+			res = append(res, col)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,178 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestQueriesNilMatrix(t *testing.T) {
+	var m *Matrix[int]
+	f := func(cell int) bool { return true }
+
+	checks := []error{}
+	_, err := m.AnyOfRow(0, f)
+	checks = append(checks, err)
+	_, err = m.AnyOfColumn(0, f)
+	checks = append(checks, err)
+	_, err = m.NoneOfRow(0, f)
+	checks = append(checks, err)
+	_, err = m.NoneOfColumn(0, f)
+	checks = append(checks, err)
+	_, err = m.AllOfPoints(NewPointList(), f)
+	checks = append(checks, err)
+	_, err = m.NoneOfPoints(NewPointList(), f)
+	checks = append(checks, err)
+	_, err = m.AllOf(f)
+	checks = append(checks, err)
+	_, err = m.AnyOf(f)
+	checks = append(checks, err)
+	_, err = m.NoneOf(f)
+	checks = append(checks, err)
+	_, err = m.CountIf(f)
+	checks = append(checks, err)
+	_, err = m.CountIfRow(0, f)
+	checks = append(checks, err)
+	_, err = m.CountIfColumn(0, f)
+	checks = append(checks, err)
+	_, _, err = m.FindFirst(f)
+	checks = append(checks, err)
+	_, err = m.FindAll(f)
+	checks = append(checks, err)
+	_, err = m.RowsAllOf(f)
+	checks = append(checks, err)
+	_, err = m.ColumnsAllOf(f)
+	checks = append(checks, err)
+
+	for i, err := range checks {
+		if err == nil || err.Error() != NilMatrixObject {
+			t.Errorf("check %d: act: %v exp: %s", i, err, NilMatrixObject)
+		}
+	}
+}
+
+func TestRowColumnQueries(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 0, 3,
+		0, 0, 0,
+		7, 0, 9}, 3, 3)
+	zero := func(cell int) bool { return cell == 0 }
+
+	if _, err := m.AnyOfRow(3, zero); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if _, err := m.NoneOfColumn(-1, zero); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if _, err := m.CountIfRow(-1, zero); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if _, err := m.CountIfColumn(3, zero); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	test := func(name string, act bool, err error, exp bool) {
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if act != exp {
+			t.Errorf("%s: act: %t exp: %t", name, act, exp)
+		}
+	}
+
+	act, err := m.AnyOfRow(0, zero)
+	test("AnyOfRow 0", act, err, true)
+	act, err = m.AnyOfRow(2, func(cell int) bool { return cell > 8 })
+	test("AnyOfRow 2", act, err, true)
+	act, err = m.AnyOfColumn(0, func(cell int) bool { return cell > 8 })
+	test("AnyOfColumn 0", act, err, false)
+	act, err = m.NoneOfRow(1, zero)
+	test("NoneOfRow 1", act, err, false)
+	act, err = m.NoneOfColumn(2, func(cell int) bool { return cell == 5 })
+	test("NoneOfColumn 2", act, err, true)
+
+	count, err := m.CountIfRow(0, zero)
+	if err != nil || count != 1 {
+		t.Errorf("CountIfRow: act: %d, %v exp: 1", count, err)
+	}
+	count, err = m.CountIfColumn(1, zero)
+	if err != nil || count != 3 {
+		t.Errorf("CountIfColumn: act: %d, %v exp: 3", count, err)
+	}
+}
+
+func TestMatrixQueries(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 0, 3,
+		0, 0, 0,
+		7, 0, 9}, 3, 3)
+	zero := func(cell int) bool { return cell == 0 }
+	positive := func(cell int) bool { return cell >= 0 }
+
+	if act, _ := m.AllOf(positive); !act {
+		t.Error("AllOf: act: false exp: true")
+	}
+	if act, _ := m.AllOf(zero); act {
+		t.Error("AllOf: act: true exp: false")
+	}
+	if act, _ := m.AnyOf(func(cell int) bool { return cell == 9 }); !act {
+		t.Error("AnyOf: act: false exp: true")
+	}
+	if act, _ := m.NoneOf(func(cell int) bool { return cell < 0 }); !act {
+		t.Error("NoneOf: act: false exp: true")
+	}
+	if count, _ := m.CountIf(zero); count != 5 {
+		t.Errorf("CountIf: act: %d exp: 5", count)
+	}
+
+	points := NewPointList(Point{0, 1}, Point{1, 1})
+	if act, _ := m.AllOfPoints(points, zero); !act {
+		t.Error("AllOfPoints: act: false exp: true")
+	}
+	if act, _ := m.NoneOfPoints(points, zero); act {
+		t.Error("NoneOfPoints: act: true exp: false")
+	}
+	if _, err := m.AllOfPoints(NewPointList(Point{5, 5}), zero); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	p, found, err := m.FindFirst(func(cell int) bool { return cell > 5 })
+	if err != nil || !found || p != (Point{2, 0}) {
+		t.Errorf("FindFirst: act: %v, %t, %v exp: {2 0}", p, found, err)
+	}
+	_, found, _ = m.FindFirst(func(cell int) bool { return cell > 9 })
+	if found {
+		t.Error("FindFirst: act: true exp: false")
+	}
+
+	all, err := m.FindAll(func(cell int) bool { return cell > 2 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(all, []Point{{0, 2}, {2, 0}, {2, 2}}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestCompleteLines(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 0, 1,
+		1, 1, 1,
+		0, 1, 1,
+		1, 1, 1}, 4, 3)
+	filled := func(cell int) bool { return cell == 1 }
+
+	rows, err := m.RowsAllOf(filled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(rows, []int{1, 3}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	cols, err := m.ColumnsAllOf(filled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(cols, []int{2}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,93 @@
+package matrix
+
+// Direction is a direction of cells movement
+type Direction int
+
+const (
+	// Up move cells to lower row indices
+	Up Direction = iota
+	// Down move cells to higher row indices
+	Down
+	// Left move cells to lower column indices
+	Left
+	// Right move cells to higher column indices
+	Right
+)
+
+// ShiftMode describe how cells uncovered by shift are filled
+type ShiftMode[T any] struct {
+	wrap  bool
+	value T
+}
+
+// ZeroFill fill uncovered cells with default value for type T
+func ZeroFill[T any]() ShiftMode[T] {
+	return ShiftMode[T]{}
+}
+
+// FillWith fill uncovered cells with `value`
+func FillWith[T any](value T) ShiftMode[T] {
+	return ShiftMode[T]{value: value}
+}
+
+// WrapAround move cells shifted out of matrix to the opposite side (roll)
+func WrapAround[T any]() ShiftMode[T] {
+	return ShiftMode[T]{wrap: true}
+}
+
+// Shift move all cells to `n` steps in `direction`. Negative `n` moves cells
+// in opposite direction. Cells are moved in place, so views stay attached
+func (m *Matrix[T]) Shift(direction Direction, n int, mode ShiftMode[T]) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	var dRow, dCol int
+	switch direction {
+	case Up:
+		dRow = -n
+	case Down:
+		dRow = n
+	case Left:
+		dCol = -n
+	case Right:
+		dCol = n
+	default:
+		return ErrInvalidArgument
+	}
+
+	if len(m.cells) == 0 {
+		return nil
+	}
+
+	src := make([]T, len(m.cells))
+	copy(src, m.cells)
+
+	for row := 0; row < m.rowCount; row++ {
+		fromRow, rowInside := shiftedCoord(row, dRow, m.rowCount, mode.wrap)
+		for col := 0; col < m.colCount; col++ {
+			i := calcIndex(row, col, m.colCount)
+			fromCol, colInside := shiftedCoord(col, dCol, m.colCount, mode.wrap)
+			if rowInside && colInside {
+				m.cells[i] = src[calcIndex(fromRow, fromCol, m.colCount)]
+			} else {
+				m.cells[i] = mode.value
+			}
+		}
+	}
+
+	return nil
+}
+
+// shiftedCoord get source coordinate for `coord` moved by `delta` inside `size`
+func shiftedCoord(coord, delta, size int, wrap bool) (int, bool) {
+	from := coord - delta
+	if wrap {
+		from %= size
+		if from < 0 {
+			from += size
+		}
+		return from, true
+	}
+	return from, from >= 0 && from < size
+}
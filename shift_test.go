@@ -0,0 +1,85 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestShiftErrors(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.Shift(Down, 1, ZeroFill[int]()); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m = NewZeroMatrix[int](2, 2)
+	if err := m.Shift(Direction(10), 1, ZeroFill[int]()); !errors.Is(err, ErrInvalidArgument) {
+		t.Error("check invalid argument fail")
+	}
+}
+
+func TestShift(t *testing.T) {
+	d := []int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}
+
+	test := func(direction Direction, n int, mode ShiftMode[int], exp []int) {
+		t.Run(fmt.Sprintf("%d/%d/%v", direction, n, mode), func(t *testing.T) {
+			cells := make([]int, len(d))
+			copy(cells, d)
+			m, _ := NewMatrix(cells, 3, 3)
+
+			if err := m.Shift(direction, n, mode); err != nil {
+				t.Fatal(err)
+			}
+			if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+				t.Error(cmpRes)
+			}
+		})
+	}
+
+	test(Down, 1, ZeroFill[int](), []int{
+		0, 0, 0,
+		1, 2, 3,
+		4, 5, 6})
+	test(Up, 2, FillWith(-1), []int{
+		7, 8, 9,
+		-1, -1, -1,
+		-1, -1, -1})
+	test(Left, 1, WrapAround[int](), []int{
+		2, 3, 1,
+		5, 6, 4,
+		8, 9, 7})
+	test(Right, 4, WrapAround[int](), []int{
+		3, 1, 2,
+		6, 4, 5,
+		9, 7, 8})
+	test(Right, -1, FillWith(0), []int{
+		2, 3, 0,
+		5, 6, 0,
+		8, 9, 0})
+	test(Down, 5, FillWith(7), []int{
+		7, 7, 7,
+		7, 7, 7,
+		7, 7, 7})
+	test(Up, -4, WrapAround[int](), []int{
+		7, 8, 9,
+		1, 2, 3,
+		4, 5, 6})
+	test(Left, 0, ZeroFill[int](), d)
+}
+
+func TestShiftKeepsView(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2,
+		3, 4}, 2, 2)
+	v, _ := m.View(1, 0, 1, 2)
+
+	m.Shift(Up, 1, WrapAround[int]())
+
+	val, _ := v.Get(0, 0)
+	if val != 1 {
+		t.Errorf("act: %d exp: 1", val)
+	}
+}
@@ -0,0 +1,30 @@
+package matrix
+
+// Solve get X for AX = `b`, where each column of `b` is a separate right-hand side.
+// Square system is solved with LU factorization, overdetermined one (more rows than
+// columns in `a`) is solved in least-squares sense with Householder QR
+func Solve(a *Matrix[float64], b *Matrix[float64]) (*Matrix[float64], error) {
+	if a == nil || b == nil {
+		return nil, ErrNilMatrix
+	}
+	if a.rowCount != b.rowCount {
+		return nil, dimensionError(a, b)
+	}
+	if a.rowCount < a.colCount {
+		return nil, ErrUnderdetermined
+	}
+
+	if a.rowCount == a.colCount {
+		f, err := NewLU(a)
+		if err != nil {
+			return nil, err
+		}
+		return f.Solve(b)
+	}
+
+	f, err := NewQR(a)
+	if err != nil {
+		return nil, err
+	}
+	return f.Solve(b)
+}
@@ -0,0 +1,97 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSolveErrors(t *testing.T) {
+	_, err := Solve(nil, NewZeroMatrix[float64](2, 1))
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	_, err = Solve(NewZeroMatrix[float64](3, 3), NewZeroMatrix[float64](2, 1))
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Error("check dimension mismatch fail")
+	}
+
+	_, err = Solve(NewZeroMatrix[float64](2, 3), NewZeroMatrix[float64](2, 1))
+	if !errors.Is(err, ErrUnderdetermined) {
+		t.Error("check underdetermined fail")
+	}
+
+	_, err = Solve(NewZeroMatrix[float64](2, 2), NewZeroMatrix[float64](2, 1))
+	if !errors.Is(err, ErrSingularMatrix) {
+		t.Error("check singular matrix fail")
+	}
+
+	a, _ := NewMatrix([]float64{
+		1, 2,
+		2, 4,
+		3, 6}, 3, 2)
+	_, err = Solve(a, NewZeroMatrix[float64](3, 1))
+	if !errors.Is(err, ErrSingularMatrix) {
+		t.Error("check rank deficient fail")
+	}
+}
+
+func TestSolveSquare(t *testing.T) {
+	a, _ := NewMatrix([]float64{
+		3, 2, -1,
+		2, -2, 4,
+		-1, 0.5, -1}, 3, 3)
+	b, _ := NewMatrix([]float64{
+		1, 3,
+		-2, 0,
+		0, 1}, 3, 2)
+
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.rowCount != 3 || x.colCount != 2 {
+		t.Fatal("check row and colun size")
+	}
+
+	ax, _ := Mul(a, x)
+	if cmpRes := compareFloats(ax.cells, b.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	col, _ := x.ColumnData(0)
+	if cmpRes := compareFloats(col, []float64{1, -2, -2}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestSolveLeastSquares(t *testing.T) {
+	// fit y = c0 + c1*x to points (0, 1), (1, 3), (2, 4), (3, 4)
+	a, _ := NewMatrix([]float64{
+		1, 0,
+		1, 1,
+		1, 2,
+		1, 3}, 4, 2)
+	b, _ := NewMatrix([]float64{1, 3, 4, 4}, 4, 1)
+
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(x.cells, []float64{1.5, 1}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	// consistent overdetermined system is solved exactly
+	b, _ = NewMatrix([]float64{
+		2, 0,
+		5, -1,
+		8, -2,
+		11, -3}, 4, 2)
+	x, err = Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(x.cells, []float64{2, 0, 3, -1}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
@@ -0,0 +1,118 @@
+package matrix
+
+// Rotation is a direction of 90 grad rotation
+type Rotation int
+
+// Rotation directions
+const (
+	Clockwise Rotation = iota
+	CounterClockwise
+)
+
+// Orientation is a rotation state of a piece in Super Rotation System
+type Orientation int
+
+// Orientations in SRS notation: spawn state, one turn clockwise, two turns
+// and one turn counter-clockwise
+const (
+	Orientation0 Orientation = iota
+	OrientationR
+	Orientation2
+	OrientationL
+)
+
+// Rotated get orientation after one turn to `direction`
+func (o Orientation) Rotated(direction Rotation) Orientation {
+	if direction == CounterClockwise {
+		return (o + 3) % 4
+	}
+	return (o + 1) % 4
+}
+
+// Transition is a change of piece orientation
+type Transition struct {
+	From, To Orientation
+}
+
+// KickTable map orientation transition to offsets tested in order while rotating.
+// Offsets are in matrix coordinates: positive Row moves down, positive Column moves right
+type KickTable map[Transition][]Point
+
+// SRSKicksJLSTZ make standard SRS kick table for J, L, S, T and Z pieces
+func SRSKicksJLSTZ() KickTable {
+	return KickTable{
+		{Orientation0, OrientationR}: {{0, 0}, {0, -1}, {-1, -1}, {2, 0}, {2, -1}},
+		{OrientationR, Orientation0}: {{0, 0}, {0, 1}, {1, 1}, {-2, 0}, {-2, 1}},
+		{OrientationR, Orientation2}: {{0, 0}, {0, 1}, {1, 1}, {-2, 0}, {-2, 1}},
+		{Orientation2, OrientationR}: {{0, 0}, {0, -1}, {-1, -1}, {2, 0}, {2, -1}},
+		{Orientation2, OrientationL}: {{0, 0}, {0, 1}, {-1, 1}, {2, 0}, {2, 1}},
+		{OrientationL, Orientation2}: {{0, 0}, {0, -1}, {1, -1}, {-2, 0}, {-2, -1}},
+		{OrientationL, Orientation0}: {{0, 0}, {0, -1}, {1, -1}, {-2, 0}, {-2, -1}},
+		{Orientation0, OrientationL}: {{0, 0}, {0, 1}, {-1, 1}, {2, 0}, {2, 1}},
+	}
+}
+
+// SRSKicksI make standard SRS kick table for I piece
+func SRSKicksI() KickTable {
+	return KickTable{
+		{Orientation0, OrientationR}: {{0, 0}, {0, -2}, {0, 1}, {1, -2}, {-2, 1}},
+		{OrientationR, Orientation0}: {{0, 0}, {0, 2}, {0, -1}, {-1, 2}, {2, -1}},
+		{OrientationR, Orientation2}: {{0, 0}, {0, -1}, {0, 2}, {-2, -1}, {1, 2}},
+		{Orientation2, OrientationR}: {{0, 0}, {0, 1}, {0, -2}, {2, 1}, {-1, -2}},
+		{Orientation2, OrientationL}: {{0, 0}, {0, 2}, {0, -1}, {-1, 2}, {2, -1}},
+		{OrientationL, Orientation2}: {{0, 0}, {0, -2}, {0, 1}, {1, -2}, {-2, 1}},
+		{OrientationL, Orientation0}: {{0, 0}, {0, 1}, {0, -2}, {2, 1}, {-1, -2}},
+		{Orientation0, OrientationL}: {{0, 0}, {0, -1}, {0, 2}, {-2, -1}, {1, 2}},
+	}
+}
+
+// Placement is a piece placed on board with its top left cell on Position
+type Placement[T any] struct {
+	Piece       *Matrix[T]
+	Position    Point
+	Orientation Orientation
+}
+
+// RotateOnBoard rotate piece of `current` placement to `direction` and try kick
+// offsets from `kicks` in order. Return the first placement not overlapping
+// `board` and true, or `current` and false if every test collides. A transition
+// missing in `kicks` (e.g. nil table for O piece) tests only zero offset.
+// Piece of `current` is not modified
+func RotateOnBoard[T any](board *Matrix[T], current Placement[T], direction Rotation, kicks KickTable, isEmpty func(T) bool) (Placement[T], bool, error) {
+	if board == nil || current.Piece == nil {
+		return current, false, ErrNilMatrix
+	}
+	if direction != Clockwise && direction != CounterClockwise {
+		return current, false, ErrInvalidArgument
+	}
+
+	piece := current.Piece.clone()
+	var err error
+	if direction == Clockwise {
+		err = piece.Rotate()
+	} else {
+		err = piece.RotateCCW()
+	}
+	if err != nil {
+		return current, false, err
+	}
+
+	orientation := current.Orientation.Rotated(direction)
+	offsets, ok := kicks[Transition{current.Orientation, orientation}]
+	if !ok {
+		offsets = []Point{{0, 0}}
+	}
+
+	for _, offset := range offsets {
+		pos := Point{current.Position.Row + offset.Row, current.Position.Column + offset.Column}
+		collides, err := Overlaps(board, piece, pos.Row, pos.Column, isEmpty)
+		if err != nil {
+			return current, false, err
+		}
+		if !collides {
+			return Placement[T]{piece, pos, orientation}, true, nil
+		}
+	}
+
+	return current, false, nil
+}
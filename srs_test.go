@@ -0,0 +1,114 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestOrientationRotated(t *testing.T) {
+	o := Orientation0
+	exp := []Orientation{OrientationR, Orientation2, OrientationL, Orientation0}
+	for i, e := range exp {
+		o = o.Rotated(Clockwise)
+		if o != e {
+			t.Errorf("cw %d: act: %d exp: %d", i, o, e)
+		}
+	}
+	if o = o.Rotated(CounterClockwise); o != OrientationL {
+		t.Errorf("ccw: act: %d exp: %d", o, OrientationL)
+	}
+}
+
+func TestSRSKickTables(t *testing.T) {
+	for name, table := range map[string]KickTable{"JLSTZ": SRSKicksJLSTZ(), "I": SRSKicksI()} {
+		if len(table) != 8 {
+			t.Errorf("%s: act: %d transitions exp: 8", name, len(table))
+		}
+		for tr, offsets := range table {
+			if len(offsets) != 5 || offsets[0] != (Point{0, 0}) {
+				t.Errorf("%s %v: unexpected offsets %v", name, tr, offsets)
+			}
+			back := table[Transition{tr.To, tr.From}]
+			for i := range offsets {
+				if offsets[i].Row != -back[i].Row || offsets[i].Column != -back[i].Column {
+					t.Errorf("%s %v: offsets are not reversible", name, tr)
+				}
+			}
+		}
+	}
+
+	// SRS 0->R for JLSTZ: (-1,0) (-1,+1) (0,-2) (-1,-2) with y up
+	exp := []Point{{0, 0}, {0, -1}, {-1, -1}, {2, 0}, {2, -1}}
+	if cmpRes := compareSlices(SRSKicksJLSTZ()[Transition{Orientation0, OrientationR}], exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestRotateOnBoard(t *testing.T) {
+	isEmpty := func(cell int) bool { return cell == 0 }
+
+	tPiece, _ := NewMatrix([]int{
+		0, 1, 0,
+		1, 1, 1,
+		0, 0, 0}, 3, 3)
+
+	_, _, err := RotateOnBoard(nil, Placement[int]{Piece: tPiece}, Clockwise, SRSKicksJLSTZ(), isEmpty)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	_, _, err = RotateOnBoard(NewZeroMatrix[int](4, 4), Placement[int]{Piece: tPiece}, Rotation(5), SRSKicksJLSTZ(), isEmpty)
+	if err.Error() != InvalidArgument {
+		t.Error("check invalid direction fail")
+	}
+
+	board := NewZeroMatrix[int](5, 5)
+	act, ok, err := RotateOnBoard(board, Placement[int]{tPiece, Point{1, 1}, Orientation0}, Clockwise, SRSKicksJLSTZ(), isEmpty)
+	if err != nil || !ok {
+		t.Fatalf("act: %t, %v exp: true", ok, err)
+	}
+	if act.Position != (Point{1, 1}) || act.Orientation != OrientationR {
+		t.Errorf("act: %v %d exp: {1 1} %d", act.Position, act.Orientation, OrientationR)
+	}
+	exp := []int{
+		0, 1, 0,
+		0, 1, 1,
+		0, 1, 0}
+	if cmpRes := compareSlices(act.Piece.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if cmpRes := compareSlices(tPiece.cells, []int{0, 1, 0, 1, 1, 1, 0, 0, 0}); cmpRes != nil {
+		t.Error("source piece modified:", cmpRes)
+	}
+
+	// piece in R orientation against left wall kicks right when rotated back
+	act, ok, err = RotateOnBoard(board, Placement[int]{act.Piece, Point{1, -1}, OrientationR}, CounterClockwise, SRSKicksJLSTZ(), isEmpty)
+	if err != nil || !ok {
+		t.Fatalf("act: %t, %v exp: true", ok, err)
+	}
+	if act.Position != (Point{1, 0}) || act.Orientation != Orientation0 {
+		t.Errorf("act: %v %d exp: {1 0} %d", act.Position, act.Orientation, Orientation0)
+	}
+	if cmpRes := compareSlices(act.Piece.cells, tPiece.cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	// no kick fits
+	board, _ = NewMatrix([]int{
+		9, 0, 9,
+		0, 0, 0,
+		9, 9, 9}, 3, 3)
+	current := Placement[int]{tPiece, Point{0, 0}, Orientation0}
+	act, ok, err = RotateOnBoard(board, current, Clockwise, SRSKicksJLSTZ(), isEmpty)
+	if err != nil || ok {
+		t.Fatalf("act: %t, %v exp: false", ok, err)
+	}
+	if act != current {
+		t.Error("failed rotation must return current placement")
+	}
+
+	// missing transition tests only zero offset
+	board = NewZeroMatrix[int](3, 3)
+	act, ok, _ = RotateOnBoard(board, Placement[int]{tPiece, Point{0, 0}, Orientation0}, CounterClockwise, nil, isEmpty)
+	if !ok || act.Position != (Point{0, 0}) || act.Orientation != OrientationL {
+		t.Errorf("act: %t %v %d exp: true {0 0} %d", ok, act.Position, act.Orientation, OrientationL)
+	}
+}
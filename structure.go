@@ -0,0 +1,143 @@
+package matrix
+
+// Structural operations change matrix size and reallocate cells,
+// so existing views of the matrix are detached from it.
+
+// InsertRow insert row with `data` before row `at` (`at` equal to rows count
+// means append). Length of `data` must be equal to columns count; for matrix
+// without cells columns count is taken from `data`
+func (m *Matrix[T]) InsertRow(at int, data []T) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if at < 0 || at > m.rowCount {
+		return m.rowError(at)
+	}
+	if m.rowCount == 0 && m.colCount == 0 {
+		m.colCount = len(data)
+	}
+	if len(data) != m.colCount {
+		return &SizeError{Expected: m.colCount, Actual: len(data)}
+	}
+
+	cells := make([]T, 0, len(m.cells)+m.colCount)
+	cells = append(cells, m.cells[:at*m.colCount]...)
+	cells = append(cells, data...)
+	cells = append(cells, m.cells[at*m.colCount:]...)
+
+	m.cells = cells
+	m.rowCount++
+
+	return nil
+}
+
+// InsertColumn insert column with `data` before column `at` (`at` equal to
+// columns count means append). Length of `data` must be equal to rows count;
+// for matrix without cells rows count is taken from `data`
+func (m *Matrix[T]) InsertColumn(at int, data []T) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if at < 0 || at > m.colCount {
+		return m.columnError(at)
+	}
+	if m.rowCount == 0 && m.colCount == 0 {
+		m.rowCount = len(data)
+	}
+	if len(data) != m.rowCount {
+		return &SizeError{Expected: m.rowCount, Actual: len(data)}
+	}
+
+	cells := make([]T, 0, len(m.cells)+m.rowCount)
+	for row := 0; row < m.rowCount; row++ {
+		start := row * m.colCount
+		cells = append(cells, m.cells[start:start+at]...)
+		cells = append(cells, data[row])
+		cells = append(cells, m.cells[start+at:start+m.colCount]...)
+	}
+
+	m.cells = cells
+	m.colCount++
+
+	return nil
+}
+
+// AppendRow add row with `data` after the last row
+func (m *Matrix[T]) AppendRow(data []T) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	return m.InsertRow(m.rowCount, data)
+}
+
+// AppendColumn add column with `data` after the last column
+func (m *Matrix[T]) AppendColumn(data []T) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	return m.InsertColumn(m.colCount, data)
+}
+
+// DeleteRow remove row `r` and decrease rows count.
+// Unlike RemoveRow matrix size is changed
+func (m *Matrix[T]) DeleteRow(r int) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if r < 0 || r >= m.rowCount {
+		return m.rowError(r)
+	}
+
+	cells := make([]T, 0, len(m.cells)-m.colCount)
+	cells = append(cells, m.cells[:r*m.colCount]...)
+	cells = append(cells, m.cells[(r+1)*m.colCount:]...)
+
+	m.cells = cells
+	m.rowCount--
+
+	return nil
+}
+
+// DeleteColumn remove column `c` and decrease columns count
+func (m *Matrix[T]) DeleteColumn(c int) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if c < 0 || c >= m.colCount {
+		return m.columnError(c)
+	}
+
+	cells := make([]T, 0, len(m.cells)-m.rowCount)
+	for row := 0; row < m.rowCount; row++ {
+		start := row * m.colCount
+		cells = append(cells, m.cells[start:start+c]...)
+		cells = append(cells, m.cells[start+c+1:start+m.colCount]...)
+	}
+
+	m.cells = cells
+	m.colCount--
+
+	return nil
+}
+
+// Resize change matrix size to `rows` x `cols`. Cells inside both old and new
+// bounds keep their values, new cells get default value for type T
+func (m *Matrix[T]) Resize(rows, cols int) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+	if rows < 0 || cols < 0 {
+		return ErrInvalidSize
+	}
+
+	cells := make([]T, rows*cols)
+	keepRows, keepCols := min(rows, m.rowCount), min(cols, m.colCount)
+	for row := 0; row < keepRows; row++ {
+		copy(cells[row*cols:row*cols+keepCols], m.cells[row*m.colCount:row*m.colCount+keepCols])
+	}
+
+	m.cells = cells
+	m.rowCount, m.colCount = rows, cols
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func checkSize[T any](t *testing.T, m *Matrix[T], rows, cols int) {
+	t.Helper()
+	if m.rowCount != rows || m.colCount != cols || len(m.cells) != rows*cols {
+		t.Errorf("act: %dx%d (%d cells) exp: %dx%d", m.rowCount, m.colCount, len(m.cells), rows, cols)
+	}
+}
+
+func TestInsertRow(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.InsertRow(0, nil); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	if err := m.InsertRow(3, []int{0, 0, 0}); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	err := m.InsertRow(1, []int{0, 0})
+	var sizeErr *SizeError
+	if !errors.As(err, &sizeErr) || sizeErr.Expected != 3 || sizeErr.Actual != 2 {
+		t.Errorf("act: %v exp: SizeError{3, 2}", err)
+	}
+
+	if err = m.InsertRow(1, []int{7, 8, 9}); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.InsertRow(0, []int{-1, -2, -3}); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.AppendRow([]int{10, 11, 12}); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []int{
+		-1, -2, -3,
+		1, 2, 3,
+		7, 8, 9,
+		4, 5, 6,
+		10, 11, 12}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 5, 3)
+
+	empty := NewZeroMatrix[int](0, 0)
+	if err = empty.AppendRow([]int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	checkSize(t, empty, 1, 2)
+}
+
+func TestInsertColumn(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.AppendColumn(nil); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	if err := m.InsertColumn(-1, []int{0, 0}); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+	if err := m.InsertColumn(0, []int{0, 0, 0}); !errors.Is(err, ErrInvalidSize) {
+		t.Error("check invalid size fail")
+	}
+
+	if err := m.InsertColumn(1, []int{7, 8}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AppendColumn([]int{9, 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []int{
+		1, 7, 2, 3, 9,
+		4, 8, 5, 6, 10}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 2, 5)
+
+	empty := NewZeroMatrix[int](0, 0)
+	if err := empty.InsertColumn(0, []int{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	checkSize(t, empty, 3, 1)
+}
+
+func TestDeleteRow(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.DeleteRow(0); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2,
+		3, 4,
+		5, 6}, 3, 2)
+
+	if err := m.DeleteRow(3); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	if err := m.DeleteRow(1); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, []int{1, 2, 5, 6}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 2, 2)
+
+	m.DeleteRow(1)
+	m.DeleteRow(0)
+	checkSize(t, m, 0, 2)
+}
+
+func TestDeleteColumn(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.DeleteColumn(0); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	if err := m.DeleteColumn(-1); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	if err := m.DeleteColumn(0); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, []int{2, 3, 5, 6}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 2, 2)
+
+	if err := m.DeleteColumn(1); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, []int{2, 5}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 2, 1)
+}
+
+func TestResize(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.Resize(1, 1); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+
+	if err := m.Resize(-1, 2); err.Error() != InvalidMatrixSize {
+		t.Error("check invalid size fail")
+	}
+
+	if err := m.Resize(3, 2); err != nil {
+		t.Fatal(err)
+	}
+	exp := []int{
+		1, 2,
+		4, 5,
+		0, 0}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 3, 2)
+
+	if err := m.Resize(1, 4); err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(m.cells, []int{1, 2, 0, 0}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	checkSize(t, m, 1, 4)
+}
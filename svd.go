@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"math"
+)
+
+// SVD get thin singular value decomposition A = U Σ Vᵀ of matrix `m` with
+// one-sided Jacobi method. For A with size r x c and k = min(r, c) `u` has
+// size r x k with orthonormal columns, `sigma` is k x k diagonal matrix with
+// singular values in descending order and `vt` has size k x c.
+// Return ErrNoConvergence if columns are not orthogonalized in 100 sweeps
+func SVD(m *Matrix[float64]) (u, sigma, vt *Matrix[float64], err error) {
+	if m == nil {
+		return nil, nil, nil, ErrNilMatrix
+	}
+
+	if m.rowCount < m.colCount {
+		t := m.clone()
+		t.Transpose()
+		var v, ut *Matrix[float64]
+		v, sigma, ut, err = SVD(t)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		v.Transpose()
+		ut.Transpose()
+		return ut, sigma, v, nil
+	}
+
+	rows, n := m.rowCount, m.colCount
+	a := m.clone()
+	v := Identity[float64](n)
+	eps := epsilon[float64]()
+
+	// columns with squared norm under `negligible` are treated as zero
+	var negligible float64
+	for _, cell := range a.cells {
+		negligible += cell * cell
+	}
+	negligible *= eps * eps
+
+	converged := false
+	for sweep := 0; sweep < maxSweeps && !converged; sweep++ {
+		converged = true
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				var alpha, beta, gamma float64
+				for k := 0; k < rows; k++ {
+					kp, kq := a.cells[k*n+p], a.cells[k*n+q]
+					alpha += kp * kp
+					beta += kq * kq
+					gamma += kp * kq
+				}
+				if alpha <= negligible || beta <= negligible || math.Abs(gamma) <= eps*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				zeta := (beta - alpha) / (2 * gamma)
+				t := 1 / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				rotateColumns(a.cells, rows, n, p, q, c, s)
+				rotateColumns(v.cells, n, n, p, q, c, s)
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, nil, ErrNoConvergence
+	}
+
+	values := make([]float64, n)
+	for j := 0; j < n; j++ {
+		var norm float64
+		for k := 0; k < rows; k++ {
+			norm = math.Hypot(norm, a.cells[k*n+j])
+		}
+		values[j] = norm
+	}
+
+	u = NewZeroMatrix[float64](rows, n)
+	sigma = NewZeroMatrix[float64](n, n)
+	vt = NewZeroMatrix[float64](n, n)
+	tol := float64(rows) * eps * maxAbs(values)
+	for j, from := range descendingOrder(values) {
+		sigma.cells[j*n+j] = values[from]
+		for k := 0; k < n; k++ {
+			vt.cells[j*n+k] = v.cells[k*n+from]
+		}
+		if values[from] <= tol {
+			continue
+		}
+		for k := 0; k < rows; k++ {
+			u.cells[k*n+j] = a.cells[k*n+from] / values[from]
+		}
+	}
+	completeBasis(u, tol)
+
+	return u, sigma, vt, nil
+}
+
+// completeBasis replace zero columns of `u` with unit vectors orthogonal
+// to other columns
+func completeBasis(u *Matrix[float64], tol float64) {
+	rows, n := u.rowCount, u.colCount
+	candidate := 0
+	for j := 0; j < n; j++ {
+		var norm float64
+		for k := 0; k < rows; k++ {
+			norm = math.Hypot(norm, u.cells[k*n+j])
+		}
+		if norm > tol {
+			continue
+		}
+
+		for ; candidate < rows; candidate++ {
+			for k := 0; k < rows; k++ {
+				u.cells[k*n+j] = 0
+			}
+			u.cells[candidate*n+j] = 1
+			for other := 0; other < n; other++ {
+				if other == j {
+					continue
+				}
+				var dot float64
+				for k := 0; k < rows; k++ {
+					dot += u.cells[k*n+other] * u.cells[k*n+j]
+				}
+				for k := 0; k < rows; k++ {
+					u.cells[k*n+j] -= dot * u.cells[k*n+other]
+				}
+			}
+
+			norm = 0
+			for k := 0; k < rows; k++ {
+				norm = math.Hypot(norm, u.cells[k*n+j])
+			}
+			if norm > 0.5 {
+				for k := 0; k < rows; k++ {
+					u.cells[k*n+j] /= norm
+				}
+				candidate++
+				break
+			}
+		}
+	}
+}
+
+// singularValues get singular values of `m` in descending order
+func singularValues(m *Matrix[float64]) ([]float64, error) {
+	_, sigma, _, err := SVD(m)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float64, sigma.rowCount)
+	for i := range values {
+		values[i] = sigma.cells[i*sigma.colCount+i]
+	}
+	return values, nil
+}
+
+// Rank get numerical rank of `m`: count of singular values greater than `tol`.
+// Non-positive `tol` means max(rows, columns) * eps * largest singular value
+func Rank(m *Matrix[float64], tol float64) (int, error) {
+	values, err := singularValues(m)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	if tol <= 0 {
+		size := m.rowCount
+		if m.colCount > size {
+			size = m.colCount
+		}
+		tol = float64(size) * epsilon[float64]() * values[0]
+	}
+
+	rank := 0
+	for _, value := range values {
+		if value > tol {
+			rank++
+		}
+	}
+	return rank, nil
+}
+
+// Cond get 2-norm condition number of `m`: ratio of the largest singular value
+// to the smallest one. Return +Inf for rank deficient matrix
+func Cond(m *Matrix[float64]) (float64, error) {
+	values, err := singularValues(m)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) == 0 || values[len(values)-1] == 0 {
+		return math.Inf(1), nil
+	}
+	return values[0] / values[len(values)-1], nil
+}
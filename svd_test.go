@@ -0,0 +1,130 @@
+package matrix
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func checkSVD(t *testing.T, m *Matrix[float64]) {
+	u, sigma, vt, err := SVD(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := m.rowCount
+	if m.colCount < k {
+		k = m.colCount
+	}
+	if u.rowCount != m.rowCount || u.colCount != k || sigma.rowCount != k ||
+		sigma.colCount != k || vt.rowCount != k || vt.colCount != m.colCount {
+		t.Fatal("check row and colun size")
+	}
+
+	for i := 1; i < k; i++ {
+		prev, _ := sigma.Get(i-1, i-1)
+		cur, _ := sigma.Get(i, i)
+		if cur > prev || cur < 0 {
+			t.Errorf("singular values are not sorted: %v", sigma.cells)
+		}
+	}
+
+	us, _ := Mul(u, sigma)
+	usvt, _ := Mul(us, vt)
+	if cmpRes := compareFloats(usvt.cells, m.cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	ut := u.clone()
+	ut.Transpose()
+	utu, _ := Mul(ut, u)
+	if cmpRes := compareFloats(utu.cells, Identity[float64](k).cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	v := vt.clone()
+	v.Transpose()
+	vtv, _ := Mul(vt, v)
+	if cmpRes := compareFloats(vtv.cells, Identity[float64](k).cells, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestSVD(t *testing.T) {
+	_, _, _, err := SVD(nil)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	test := func(d []float64, rows, columns int) {
+		t.Run(fmt.Sprintf("%dx%d", rows, columns), func(t *testing.T) {
+			m, _ := NewMatrix(d, rows, columns)
+			checkSVD(t, m)
+		})
+	}
+
+	test([]float64{3, 2, 2, 3, 1, 2, 2, -2}, 4, 2)
+	test([]float64{3, 2, 2, 2, 3, -2}, 2, 3)
+	test([]float64{1, 2, 3, 2, 4, 6, 1, 1, 1}, 3, 3)
+	test([]float64{0, 0, 0, 0}, 2, 2)
+}
+
+func TestSVDValues(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		3, 2, 2,
+		2, 3, -2}, 2, 3)
+
+	_, sigma, _, err := SVD(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareFloats(sigma.cells, []float64{5, 0, 0, 3}, 1e-12); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestRank(t *testing.T) {
+	test := func(d []float64, rows, columns, exp int) {
+		t.Run(fmt.Sprint(d), func(t *testing.T) {
+			m, _ := NewMatrix(d, rows, columns)
+			act, err := Rank(m, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act != exp {
+				t.Errorf("act: %d exp: %d", act, exp)
+			}
+		})
+	}
+
+	test([]float64{1, 2, 3, 4}, 2, 2, 2)
+	test([]float64{1, 2, 2, 4}, 2, 2, 1)
+	test([]float64{1, 2, 3, 2, 4, 6, 1, 1, 1}, 3, 3, 2)
+	test([]float64{0, 0, 0}, 1, 3, 0)
+
+	if _, err := Rank(nil, 0); err.Error() != NilMatrixObject {
+		t.Error("check nil object fail")
+	}
+}
+
+func TestCond(t *testing.T) {
+	m, _ := NewMatrix([]float64{
+		2, 0,
+		0, 0.5}, 2, 2)
+	act, err := Cond(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(act-4) > 1e-12 {
+		t.Errorf("act: %v exp: 4", act)
+	}
+
+	m, _ = NewMatrix([]float64{1, 1, 1, 1}, 2, 2)
+	act, err = Cond(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act < 1e15 {
+		t.Errorf("act: %v exp: +Inf or huge", act)
+	}
+}
@@ -0,0 +1,82 @@
+package matrix
+
+import (
+	"cmp"
+	"slices"
+)
+
+// variants call `f` for each of eight D4 transforms of `m` in element order
+func variants[T any](m *Matrix[T], f func(e D4Element, v *Matrix[T])) error {
+	for e := D4Identity; e <= D4Transpose; e++ {
+		v := m.clone()
+		err := v.Transform(e)
+		if err != nil {
+			return err
+		}
+		f(e, v)
+	}
+	return nil
+}
+
+// Canonical get lexicographically minimal of eight rotations and reflections of `m`
+// and transform making it from `m`. Variants are ordered by row count, column count
+// and then by cells in row-major order. Two matrices are equal up to rotation and
+// reflection if and only if their canonical forms are equal
+func Canonical[T cmp.Ordered](m *Matrix[T]) (*Matrix[T], D4Element, error) {
+	return CanonicalFunc(m, cmp.Compare[T])
+}
+
+// CanonicalFunc is like Canonical but compare cells with `compare` function
+func CanonicalFunc[T any](m *Matrix[T], compare func(a, b T) int) (*Matrix[T], D4Element, error) {
+	if m == nil {
+		return nil, D4Identity, ErrNilMatrix
+	}
+
+	var best *Matrix[T]
+	bestElement := D4Identity
+	err := variants(m, func(e D4Element, v *Matrix[T]) {
+		if best == nil || compareVariants(v, best, compare) < 0 {
+			best, bestElement = v, e
+		}
+	})
+	if err != nil {
+		return nil, D4Identity, err
+	}
+
+	return best, bestElement, nil
+}
+
+func compareVariants[T any](a, b *Matrix[T], compare func(a, b T) int) int {
+	if c := cmp.Compare(a.rowCount, b.rowCount); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.colCount, b.colCount); c != 0 {
+		return c
+	}
+	return slices.CompareFunc(a.cells, b.cells, compare)
+}
+
+// Symmetries get transforms leaving `m` unchanged in element order.
+// Result always contains D4Identity
+func Symmetries[T comparable](m *Matrix[T]) ([]D4Element, error) {
+	return SymmetriesFunc(m, func(a, b T) bool { return a == b })
+}
+
+// SymmetriesFunc is like Symmetries but compare cells with `eq` function
+func SymmetriesFunc[T any](m *Matrix[T], eq func(a, b T) bool) ([]D4Element, error) {
+	if m == nil {
+		return []D4Element{}, ErrNilMatrix
+	}
+
+	res := make([]D4Element, 0)
+	err := variants(m, func(e D4Element, v *Matrix[T]) {
+		if v.rowCount == m.rowCount && v.colCount == m.colCount && slices.EqualFunc(v.cells, m.cells, eq) {
+			res = append(res, e)
+		}
+	})
+	if err != nil {
+		return []D4Element{}, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,108 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	var m *Matrix[int]
+	if _, _, err := Canonical(m); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	// L tetromino in all orientations has the same canonical form
+	l, _ := NewMatrix([]int{
+		1, 0,
+		1, 0,
+		1, 1}, 3, 2)
+	exp := []int{
+		0, 0, 1,
+		1, 1, 1}
+
+	for e := D4Identity; e <= D4Transpose; e++ {
+		v := l.clone()
+		v.Transform(e)
+
+		act, used, err := Canonical(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmpRes := compareSlices(act.cells, exp); cmpRes != nil || act.rowCount != 2 {
+			t.Errorf("element %d: %v", e, cmpRes)
+		}
+
+		check := v.clone()
+		check.Transform(used)
+		if cmpRes := compareSlices(check.cells, act.cells); cmpRes != nil {
+			t.Errorf("element %d: transform %d does not make canonical form: %v", e, used, cmpRes)
+		}
+	}
+	if cmpRes := compareSlices(l.cells, []int{1, 0, 1, 0, 1, 1}); cmpRes != nil {
+		t.Error("source matrix modified:", cmpRes)
+	}
+
+	s, _ := NewMatrix([]int{
+		0, 1, 1,
+		1, 1, 0}, 2, 3)
+	z, _ := NewMatrix([]int{
+		1, 1, 0,
+		0, 1, 1}, 2, 3)
+	cs, _, _ := Canonical(s)
+	cz, _, _ := Canonical(z)
+	if cmpRes := compareSlices(cs.cells, cz.cells); cmpRes != nil {
+		t.Error("mirrored shapes must have the same canonical form:", cmpRes)
+	}
+
+	act, used, _ := CanonicalFunc(z, func(a, b int) int { return b - a })
+	if cmpRes := compareSlices(act.cells, []int{1, 1, 0, 0, 1, 1}); cmpRes != nil || used != D4Identity {
+		t.Errorf("act: %v %d exp: identity", act.cells, used)
+	}
+}
+
+func TestSymmetries(t *testing.T) {
+	var m *Matrix[int]
+	if _, err := Symmetries(m); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	test := func(name string, m *Matrix[int], exp []D4Element) {
+		act, err := Symmetries(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmpRes := compareSlices(act, exp); cmpRes != nil {
+			t.Errorf("%s: %v", name, cmpRes)
+		}
+	}
+
+	square, _ := NewMatrix([]int{
+		1, 1,
+		1, 1}, 2, 2)
+	test("square", square, []D4Element{D4Identity, D4Rotate90, D4Rotate180, D4Rotate270,
+		D4MirrorColumns, D4AntiTranspose, D4MirrorRows, D4Transpose})
+
+	tPiece, _ := NewMatrix([]int{
+		1, 1, 1,
+		0, 1, 0}, 2, 3)
+	test("T", tPiece, []D4Element{D4Identity, D4MirrorColumns})
+
+	s, _ := NewMatrix([]int{
+		0, 1, 1,
+		1, 1, 0}, 2, 3)
+	test("S", s, []D4Element{D4Identity, D4Rotate180})
+
+	plain, _ := NewMatrix([]int{
+		1, 2,
+		3, 4}, 2, 2)
+	test("plain", plain, []D4Element{D4Identity})
+
+	sym, _ := NewMatrix([]int{
+		1, 2,
+		2, 1}, 2, 2)
+	test("symmetric", sym, []D4Element{D4Identity, D4Rotate180, D4AntiTranspose, D4Transpose})
+
+	act, _ := SymmetriesFunc(plain, func(a, b int) bool { return a%2 == b%2 })
+	if cmpRes := compareSlices(act, []D4Element{D4Identity, D4MirrorRows}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
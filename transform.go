@@ -0,0 +1,139 @@
+package matrix
+
+import (
+	"slices"
+)
+
+// Rotate180 rotate matrix to 180 grad
+func (m *Matrix[T]) Rotate180() error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	slices.Reverse(m.cells)
+
+	return nil
+}
+
+// RotateN rotate matrix to 90 grad clockwise `k` times, negative `k` rotates counter-clockwise
+func (m *Matrix[T]) RotateN(k int) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	switch (k%4 + 4) % 4 {
+	case 1:
+		return m.Rotate()
+	case 2:
+		return m.Rotate180()
+	case 3:
+		return m.RotateCCW()
+	}
+
+	return nil
+}
+
+// AntiTranspose transpose matrix over anti-diagonal. Square matrix is transposed in place
+func (m *Matrix[T]) AntiTranspose() error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	if m.rowCount != m.colCount {
+		err := m.Transpose()
+		if err != nil {
+			return err
+		}
+		return m.Rotate180()
+	}
+
+	n := m.rowCount
+	for row := 0; row < n; row++ {
+		for col := 0; col < n-1-row; col++ {
+			a, _ := m.index(row, col)
+			b, _ := m.index(n-1-col, n-1-row)
+			m.cells[a], m.cells[b] = m.cells[b], m.cells[a]
+		}
+	}
+
+	return nil
+}
+
+// D4Element is one of eight symmetries of a rectangle grid (dihedral group D4).
+// Every element is a mirror of columns (for reflections only) followed by
+// clockwise rotations
+type D4Element int
+
+// D4 elements
+const (
+	D4Identity D4Element = iota
+	D4Rotate90
+	D4Rotate180
+	D4Rotate270
+	D4MirrorColumns
+	D4AntiTranspose
+	D4MirrorRows
+	D4Transpose
+)
+
+// d4Element make element from `k` clockwise rotations after optional mirror of columns
+func d4Element(k int, flip bool) D4Element {
+	e := D4Element((k%4 + 4) % 4)
+	if flip {
+		e += 4
+	}
+	return e
+}
+
+func (e D4Element) rotations() int {
+	return int(e) % 4
+}
+
+func (e D4Element) flipped() bool {
+	return e >= 4
+}
+
+// Compose get element equal to applying `a` and then `b`
+func Compose(a, b D4Element) D4Element {
+	k := a.rotations()
+	if b.flipped() {
+		k = -k
+	}
+	return d4Element(k+b.rotations(), a.flipped() != b.flipped())
+}
+
+// Inverse get element undoing `e`
+func (e D4Element) Inverse() D4Element {
+	if e.flipped() {
+		return e
+	}
+	return d4Element(-e.rotations(), false)
+}
+
+// Transform apply symmetry `e` to matrix
+func (m *Matrix[T]) Transform(e D4Element) error {
+	if m == nil {
+		return ErrNilMatrix
+	}
+
+	switch e {
+	case D4Identity:
+		return nil
+	case D4Rotate90:
+		return m.Rotate()
+	case D4Rotate180:
+		return m.Rotate180()
+	case D4Rotate270:
+		return m.RotateCCW()
+	case D4MirrorColumns:
+		return m.MirrorColumns()
+	case D4AntiTranspose:
+		return m.AntiTranspose()
+	case D4MirrorRows:
+		return m.MirrorRows()
+	case D4Transpose:
+		return m.Transpose()
+	}
+
+	return ErrInvalidArgument
+}
@@ -0,0 +1,151 @@
+package matrix
+
+import (
+	"testing"
+)
+
+func transformSample() *Matrix[int] {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+	return m
+}
+
+func TestRotate180(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.Rotate180(); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m = transformSample()
+	m.Rotate180()
+	exp := []int{
+		6, 5, 4,
+		3, 2, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if m.rowCount != 2 || m.colCount != 3 {
+		t.Error("check row and colun size")
+	}
+}
+
+func TestRotateN(t *testing.T) {
+	for k := -5; k <= 5; k++ {
+		act := transformSample()
+		if err := act.RotateN(k); err != nil {
+			t.Fatal(err)
+		}
+
+		exp := transformSample()
+		for i := 0; i < (k%4+4)%4; i++ {
+			exp.Rotate()
+		}
+		if cmpRes := compareSlices(act.cells, exp.cells); cmpRes != nil || act.rowCount != exp.rowCount {
+			t.Errorf("k = %d: %v", k, cmpRes)
+		}
+	}
+}
+
+func TestAntiTranspose(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.AntiTranspose(); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m = transformSample()
+	m.AntiTranspose()
+	exp := []int{
+		6, 3,
+		5, 2,
+		4, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if m.rowCount != 3 || m.colCount != 2 {
+		t.Error("check row and colun size")
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	first := &m.cells[0]
+	m.AntiTranspose()
+	exp = []int{
+		9, 6, 3,
+		8, 5, 2,
+		7, 4, 1}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if first != &m.cells[0] {
+		t.Error("square matrix is not transformed in place")
+	}
+}
+
+func TestTransposeSquareInPlace(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	first := &m.cells[0]
+	m.Transpose()
+	exp := []int{
+		1, 4, 7,
+		2, 5, 8,
+		3, 6, 9}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if first != &m.cells[0] {
+		t.Error("square matrix is not transposed in place")
+	}
+}
+
+func TestTransform(t *testing.T) {
+	var m *Matrix[int]
+	if err := m.Transform(D4Rotate90); err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+	if err := transformSample().Transform(D4Element(8)); err.Error() != InvalidArgument {
+		t.Error("check invalid element fail")
+	}
+
+	// every element is a mirror of columns for reflections followed by clockwise rotations
+	for e := D4Identity; e <= D4Transpose; e++ {
+		act := transformSample()
+		if err := act.Transform(e); err != nil {
+			t.Fatal(err)
+		}
+
+		exp := transformSample()
+		if e >= D4MirrorColumns {
+			exp.MirrorColumns()
+		}
+		exp.RotateN(int(e) % 4)
+		if cmpRes := compareSlices(act.cells, exp.cells); cmpRes != nil || act.rowCount != exp.rowCount {
+			t.Errorf("element %d: %v", e, cmpRes)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	for a := D4Identity; a <= D4Transpose; a++ {
+		for b := D4Identity; b <= D4Transpose; b++ {
+			act := transformSample()
+			act.Transform(a)
+			act.Transform(b)
+
+			exp := transformSample()
+			exp.Transform(Compose(a, b))
+			if cmpRes := compareSlices(act.cells, exp.cells); cmpRes != nil || act.rowCount != exp.rowCount {
+				t.Errorf("%d then %d: %v", a, b, cmpRes)
+			}
+		}
+
+		if e := Compose(a, a.Inverse()); e != D4Identity {
+			t.Errorf("%d: act: %d exp: identity", a, e)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package matrix
+
+// View is a rectangular window over matrix cells. View shares memory with
+// its matrix, so writes through view change the matrix and vice versa.
+// Matrix operations which change matrix shape (like Transpose or Rotate of
+// non-square matrix, InsertRow or Resize) reallocate cells and detach existing views.
+// Square matrices are transposed and rotated in place, so their views stay attached
+type View[T any] struct {
+	cells    []T
+	offset   int
+	stride   int
+	rowCount int
+	colCount int
+}
+
+// View get window with `rows` x `cols` cells starting from cell [row, col]
+func (m *Matrix[T]) View(row, col, rows, cols int) (*View[T], error) {
+	if m == nil {
+		return nil, ErrNilMatrix
+	}
+
+	whole := &View[T]{m.cells, 0, m.colCount, m.rowCount, m.colCount}
+	return whole.View(row, col, rows, cols)
+}
+
+// View get window with `rows` x `cols` cells starting from view cell [row, col]
+func (v *View[T]) View(row, col, rows, cols int) (*View[T], error) {
+	if v == nil {
+		return nil, ErrNilMatrix
+	}
+	if row < 0 || col < 0 {
+		return nil, v.cellError(row, col)
+	}
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvalidSize
+	}
+	if row+rows > v.rowCount || col+cols > v.colCount {
+		return nil, v.cellError(row+rows-1, col+cols-1)
+	}
+
+	return &View[T]{v.cells, v.offset + row*v.stride + col, v.stride, rows, cols}, nil
+}
+
+// cellError make IndexError for view cell [row, col]
+func (v *View[T]) cellError(row, col int) error {
+	return &IndexError{Row: row, Column: col, Rows: v.rowCount, Columns: v.colCount}
+}
+
+// Size get rows and columns count of view
+func (v *View[T]) Size() (int, int) {
+	if v == nil {
+		return 0, 0
+	}
+	return v.rowCount, v.colCount
+}
+
+// at convert view coords into index of shared cells without checks
+func (v *View[T]) at(row, col int) int {
+	return v.offset + row*v.stride + col
+}
+
+// index convert view coords into index of shared cells
+func (v *View[T]) index(row, col int) (int, error) {
+	if v == nil {
+		return 0, ErrNilMatrix
+	}
+	if row < 0 || col < 0 || row >= v.rowCount || col >= v.colCount {
+		return 0, v.cellError(row, col)
+	}
+	return v.at(row, col), nil
+}
+
+// Get `value` from view on [row,column]
+func (v *View[T]) Get(row, column int) (T, error) {
+	var empty T
+	i, err := v.index(row, column)
+	if err != nil {
+		return empty, err
+	}
+	return v.cells[i], nil
+}
+
+// Set value `value` to view cell [row, column]
+func (v *View[T]) Set(row, column int, value T) error {
+	i, err := v.index(row, column)
+	if err != nil {
+		return err
+	}
+	v.cells[i] = value
+	return nil
+}
+
+// Each call `f` for each view cell row by row
+func (v *View[T]) Each(f func(row, column int, cell T)) error {
+	if v == nil {
+		return ErrNilMatrix
+	}
+
+	for row := 0; row < v.rowCount; row++ {
+		for col := 0; col < v.colCount; col++ {
+			f(row, col, v.cells[v.at(row, col)])
+		}
+	}
+	return nil
+}
+
+// Fill set `value` to each view cell
+func (v *View[T]) Fill(value T) error {
+	if v == nil {
+		return ErrNilMatrix
+	}
+
+	for row := 0; row < v.rowCount; row++ {
+		start := v.at(row, 0)
+		for i := start; i < start+v.colCount; i++ {
+			v.cells[i] = value
+		}
+	}
+	return nil
+}
+
+// Copy make new matrix with copy of view cells
+func (v *View[T]) Copy() (*Matrix[T], error) {
+	if v == nil {
+		return nil, ErrNilMatrix
+	}
+
+	m := NewZeroMatrix[T](v.rowCount, v.colCount)
+	for row := 0; row < v.rowCount; row++ {
+		start := v.at(row, 0)
+		copy(m.cells[row*v.colCount:(row+1)*v.colCount], v.cells[start:start+v.colCount])
+	}
+	return m, nil
+}
+
+// MirrorRows reverse row order inside view
+func (v *View[T]) MirrorRows() error {
+	if v == nil {
+		return ErrNilMatrix
+	}
+
+	for bRow, eRow := 0, v.rowCount-1; bRow < eRow; bRow, eRow = bRow+1, eRow-1 {
+		for c := 0; c < v.colCount; c++ {
+			b, e := v.at(bRow, c), v.at(eRow, c)
+			v.cells[b], v.cells[e] = v.cells[e], v.cells[b]
+		}
+	}
+	return nil
+}
+
+// MirrorColumns reverse column order inside view
+func (v *View[T]) MirrorColumns() error {
+	if v == nil {
+		return ErrNilMatrix
+	}
+
+	for bCol, eCol := 0, v.colCount-1; bCol < eCol; bCol, eCol = bCol+1, eCol-1 {
+		for row := 0; row < v.rowCount; row++ {
+			b, e := v.at(row, bCol), v.at(row, eCol)
+			v.cells[b], v.cells[e] = v.cells[e], v.cells[b]
+		}
+	}
+	return nil
+}
+
+// Transpose transpose view in place. View must be square
+func (v *View[T]) Transpose() error {
+	if v == nil {
+		return ErrNilMatrix
+	}
+	if v.rowCount != v.colCount {
+		return ErrNotSquare
+	}
+
+	for row := 0; row < v.rowCount; row++ {
+		for col := row + 1; col < v.colCount; col++ {
+			a, b := v.at(row, col), v.at(col, row)
+			v.cells[a], v.cells[b] = v.cells[b], v.cells[a]
+		}
+	}
+	return nil
+}
+
+// Rotate rotate view to 90 grad in place. View must be square
+func (v *View[T]) Rotate() error {
+	err := v.Transpose()
+	if err != nil {
+		return err
+	}
+
+	return v.MirrorColumns()
+}
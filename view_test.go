@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestViewErrors(t *testing.T) {
+	var m *Matrix[int]
+	_, err := m.View(0, 0, 1, 1)
+	if err.Error() != NilMatrixObject {
+		t.Fatal("check nil object fail")
+	}
+
+	m = NewZeroMatrix[int](3, 4)
+	_, err = m.View(-1, 0, 1, 1)
+	if err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	_, err = m.View(1, 1, 3, 1)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Row != 3 || indexErr.Column != 1 {
+		t.Errorf("act: %v exp: IndexError at [3,1]", err)
+	}
+
+	_, err = m.View(0, 0, -1, 2)
+	if !errors.Is(err, ErrInvalidSize) {
+		t.Error("check invalid size fail")
+	}
+
+	v, err := m.View(1, 1, 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = v.Get(2, 0)
+	if !errors.As(err, &indexErr) || indexErr.Rows != 2 || indexErr.Columns != 2 {
+		t.Errorf("act: %v exp: IndexError with view size", err)
+	}
+	if err = v.Set(0, -1, 1); err.Error() != InvalidIndexError {
+		t.Error("check invalid index fail")
+	}
+
+	if err = v.Transpose(); err != nil {
+		t.Error(err)
+	}
+	v, _ = m.View(0, 0, 2, 3)
+	if err = v.Rotate(); !errors.Is(err, ErrNotSquare) {
+		t.Error("check not square fail")
+	}
+}
+
+func TestViewGetSet(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12}, 3, 4)
+
+	v, err := m.View(1, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows, cols := v.Size(); rows != 2 || cols != 3 {
+		t.Errorf("act: %dx%d exp: 2x3", rows, cols)
+	}
+
+	val, err := v.Get(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val != 12 {
+		t.Errorf("act: %d exp: 12", val)
+	}
+
+	v.Set(0, 0, 66)
+	exp := []int{
+		1, 2, 3, 4,
+		5, 66, 7, 8,
+		9, 10, 11, 12}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	inner, err := v.View(1, 1, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner.Fill(0)
+	exp = []int{
+		1, 2, 3, 4,
+		5, 66, 7, 8,
+		9, 10, 0, 0}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	m.Set(1, 2, 77)
+	if val, _ = v.Get(0, 1); val != 77 {
+		t.Errorf("act: %d exp: 77", val)
+	}
+}
+
+func TestViewEachCopy(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	v, _ := m.View(0, 1, 3, 2)
+
+	var cells []int
+	var points []struct{ Row, Column int }
+	v.Each(func(row, column int, cell int) {
+		cells = append(cells, cell)
+		points = append(points, struct{ Row, Column int }{row, column})
+	})
+	if cmpRes := compareSlices(cells, []int{2, 3, 5, 6, 8, 9}); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	if points[3] != (struct{ Row, Column int }{1, 1}) {
+		t.Errorf("act: %v exp: {1 1}", points[3])
+	}
+
+	c, err := v.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpRes := compareSlices(c.cells, cells); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+	c.Set(0, 0, 0)
+	if val, _ := m.Get(0, 1); val != 2 {
+		t.Error("copy shares cells with matrix")
+	}
+}
+
+func TestViewTransforms(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+		13, 14, 15, 16}, 4, 4)
+
+	v, _ := m.View(1, 1, 2, 3)
+	v.MirrorColumns()
+	exp := []int{
+		1, 2, 3, 4,
+		5, 8, 7, 6,
+		9, 12, 11, 10,
+		13, 14, 15, 16}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	v.MirrorRows()
+	exp = []int{
+		1, 2, 3, 4,
+		5, 12, 11, 10,
+		9, 8, 7, 6,
+		13, 14, 15, 16}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+		13, 14, 15, 16}, 4, 4)
+	v, _ = m.View(0, 1, 3, 3)
+	v.Rotate()
+	exp = []int{
+		1, 10, 6, 2,
+		5, 11, 7, 3,
+		9, 12, 8, 4,
+		13, 14, 15, 16}
+	if cmpRes := compareSlices(m.cells, exp); cmpRes != nil {
+		t.Error(cmpRes)
+	}
+}
+
+func TestViewMatrixTransforms(t *testing.T) {
+	m, _ := NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9}, 3, 3)
+	v, _ := m.View(0, 0, 1, 3)
+
+	m.Transpose()
+	m.Rotate()
+	row := []int{}
+	v.Each(func(_, _ int, cell int) { row = append(row, cell) })
+	if cmpRes := compareSlices(row, []int{3, 2, 1}); cmpRes != nil {
+		t.Error("view of square matrix detached:", cmpRes)
+	}
+
+	m, _ = NewMatrix([]int{
+		1, 2, 3,
+		4, 5, 6}, 2, 3)
+	v, _ = m.View(0, 0, 1, 3)
+	m.Transpose()
+	v.Set(0, 0, 9)
+	if act, _ := m.Get(0, 0); act != 1 {
+		t.Errorf("act: %d exp: 1, view of non-square matrix must detach", act)
+	}
+}